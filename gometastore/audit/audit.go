@@ -0,0 +1,120 @@
+// Package audit implements audit trail of metadata mutations.
+//
+// An Auditor receives events describing completed mutations, redacts sensitive
+// parameter values and passes events to all configured sinks.
+package audit
+
+import (
+	"log"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Redacted replaces values of sensitive parameters
+const Redacted = "*****"
+
+// DefaultRedactPatterns are used when no explicit patterns are specified
+var DefaultRedactPatterns = []string{"*password*", "*secret*", "*credential*", "*token*"}
+
+// Event describes a single audited call.
+type Event struct {
+	Time       time.Time         `json:"time"`
+	RequestID  string            `json:"request_id,omitempty"`
+	Principal  string            `json:"principal,omitempty"`
+	Client     string            `json:"client,omitempty"`
+	Method     string            `json:"method"`
+	Catalog    string            `json:"catalog,omitempty"`
+	Database   string            `json:"database,omitempty"`
+	DatabaseID string            `json:"database_id,omitempty"`
+	Table      string            `json:"table,omitempty"`
+	TableID    string            `json:"table_id,omitempty"`
+	ObjectID   string            `json:"object_id,omitempty"` // ID of an object of unknown kind
+	Partitions [][]string        `json:"partitions,omitempty"`
+	PartNames  []string          `json:"partition_names,omitempty"`
+	PartPrefix []string          `json:"partition_prefix,omitempty"` // Values of leading keys
+	Columns    []string          `json:"columns,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+	Count      int               `json:"count,omitempty"` // Number of requests in a stream
+	Status     string            `json:"status"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// Sink is a destination for audit events.
+type Sink interface {
+	Write(event *Event) error
+	Close() error
+}
+
+// Auditor redacts events and sends them to sinks.
+type Auditor struct {
+	mu       sync.Mutex
+	sinks    []Sink
+	patterns []string
+}
+
+// NewAuditor creates an Auditor with the given redaction patterns and sinks.
+// Patterns use path.Match syntax and are matched against lower-case parameter keys.
+func NewAuditor(patterns []string, sinks ...Sink) *Auditor {
+	var lower []string
+	for _, p := range patterns {
+		if p = strings.TrimSpace(p); p != "" {
+			lower = append(lower, strings.ToLower(p))
+		}
+	}
+	return &Auditor{sinks: sinks, patterns: lower}
+}
+
+// Log writes event to all sinks. Errors are logged and do not stop other sinks.
+func (a *Auditor) Log(event *Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	event.Parameters = a.Redact(event.Parameters)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, sink := range a.sinks {
+		if err := sink.Write(event); err != nil {
+			log.Println("failed to write audit event:", err)
+		}
+	}
+}
+
+// Redact returns a copy of parameters with sensitive values replaced.
+func (a *Auditor) Redact(parameters map[string]string) map[string]string {
+	if len(parameters) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(parameters))
+	for k, v := range parameters {
+		if a.sensitive(k) {
+			v = Redacted
+		}
+		result[k] = v
+	}
+	return result
+}
+
+func (a *Auditor) sensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, p := range a.patterns {
+		if ok, _ := path.Match(p, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Close closes all sinks.
+func (a *Auditor) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var firstErr error
+	for _, sink := range a.sinks {
+		if err := sink.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
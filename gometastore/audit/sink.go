@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileSink appends events as JSON lines to a local file and rotates it by size.
+//
+// Rotated files are renamed to <name>.1, <name>.2, ... with <name>.1 being the most
+// recent one. Every event is synced to disk before Write returns.
+type FileSink struct {
+	name       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// NewFileSink opens (or creates) the audit file.
+//
+//   name - file name
+//   maxSize - rotate when file grows beyond this many bytes, 0 disables rotation
+//   maxBackups - number of rotated files to keep
+func NewFileSink(name string, maxSize int64, maxBackups int) (*FileSink, error) {
+	s := &FileSink{name: name, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	if err := os.MkdirAll(filepath.Dir(s.name), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// rotate shifts existing backups and starts a new file.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	if s.maxBackups > 0 {
+		os.Remove(fmt.Sprintf("%s.%d", s.name, s.maxBackups))
+		for i := s.maxBackups - 1; i > 0; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", s.name, i), fmt.Sprintf("%s.%d", s.name, i+1))
+		}
+		if err := os.Rename(s.name, s.name+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(s.name); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+// Write appends event to the file.
+func (s *FileSink) Write(event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(data)) > s.maxSize {
+		if err = s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(data)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// Syslog facility and severity used for stream sink messages
+const (
+	facilityAuthPriv = 10
+	severityInfo     = 6
+)
+
+// StreamSink writes events to a stream (usually stdout) in syslog-style format:
+//
+//   <86>Jan  2 15:04:05 host hmsv2server[pid]: {json event}
+type StreamSink struct {
+	w        io.Writer
+	tag      string
+	hostname string
+}
+
+// NewStreamSink creates sink writing to w using tag as program name.
+func NewStreamSink(w io.Writer, tag string) *StreamSink {
+	hostname, _ := os.Hostname()
+	return &StreamSink{w: w, tag: tag, hostname: hostname}
+}
+
+// Write writes a single line with the event.
+func (s *StreamSink) Write(event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.w, "<%d>%s %s %s[%d]: %s\n",
+		facilityAuthPriv*8+severityInfo, event.Time.Format(time.Stamp),
+		s.hostname, s.tag, os.Getpid(), data)
+	return err
+}
+
+// Close does nothing since the stream is not owned by the sink.
+func (s *StreamSink) Close() error {
+	return nil
+}
@@ -0,0 +1,497 @@
+// Package hivetype parses Hive type strings used in FieldSchema.type.
+//
+// Types are parsed into a tree of Type values which can be validated and printed back
+// in the normalized form used by Hive: lower case names without spaces, aliases
+// replaced by canonical names and default parameters made explicit, e.g.
+//
+//	"MAP< STRING, Decimal >" -> "map<string,decimal(10,0)>"
+//
+// Supported types are primitives, decimal(p,s), char(n), varchar(n), array<T>,
+// map<K,V>, struct<name:T,...> and uniontype<T,...>.
+package hivetype
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Kind is the kind of a Hive type
+type Kind int
+
+// Hive type kinds
+const (
+	Boolean Kind = iota
+	TinyInt
+	SmallInt
+	Int
+	BigInt
+	Float
+	Double
+	String
+	Char
+	Varchar
+	Decimal
+	Date
+	Timestamp
+	Binary
+	Array
+	Map
+	Struct
+	Union
+)
+
+// Type limits
+const (
+	MaxCharLength    = 255
+	MaxVarcharLength = 65535
+	MaxPrecision     = 38
+
+	DefaultPrecision = 10
+	DefaultScale     = 0
+)
+
+var kindNames = map[Kind]string{
+	Boolean:   "boolean",
+	TinyInt:   "tinyint",
+	SmallInt:  "smallint",
+	Int:       "int",
+	BigInt:    "bigint",
+	Float:     "float",
+	Double:    "double",
+	String:    "string",
+	Char:      "char",
+	Varchar:   "varchar",
+	Decimal:   "decimal",
+	Date:      "date",
+	Timestamp: "timestamp",
+	Binary:    "binary",
+	Array:     "array",
+	Map:       "map",
+	Struct:    "struct",
+	Union:     "uniontype",
+}
+
+// typeNames maps type names and their aliases to kinds
+var typeNames = map[string]Kind{
+	"boolean":   Boolean,
+	"tinyint":   TinyInt,
+	"smallint":  SmallInt,
+	"int":       Int,
+	"integer":   Int,
+	"bigint":    BigInt,
+	"float":     Float,
+	"double":    Double,
+	"string":    String,
+	"char":      Char,
+	"varchar":   Varchar,
+	"decimal":   Decimal,
+	"dec":       Decimal,
+	"numeric":   Decimal,
+	"date":      Date,
+	"timestamp": Timestamp,
+	"binary":    Binary,
+	"array":     Array,
+	"map":       Map,
+	"struct":    Struct,
+	"uniontype": Union,
+}
+
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Type is a parsed Hive type
+type Type struct {
+	Kind      Kind
+	Length    int     // Length of char and varchar
+	Precision int     // Precision of decimal
+	Scale     int     // Scale of decimal
+	Elem      *Type   // Element type of array
+	Key       *Type   // Key type of map
+	Value     *Type   // Value type of map
+	Fields    []Field // Fields of struct
+	Members   []*Type // Member types of uniontype
+}
+
+// Field is a struct field
+type Field struct {
+	Name string
+	Type *Type
+}
+
+// IsPrimitive returns true for types which are not arrays, maps, structs or unions
+func (t *Type) IsPrimitive() bool {
+	return t.Kind < Array
+}
+
+// String returns the normalized type string
+func (t *Type) String() string {
+	var b strings.Builder
+	t.write(&b)
+	return b.String()
+}
+
+func (t *Type) write(b *strings.Builder) {
+	b.WriteString(t.Kind.String())
+	switch t.Kind {
+	case Char, Varchar:
+		fmt.Fprintf(b, "(%d)", t.Length)
+	case Decimal:
+		fmt.Fprintf(b, "(%d,%d)", t.Precision, t.Scale)
+	case Array:
+		b.WriteByte('<')
+		t.Elem.write(b)
+		b.WriteByte('>')
+	case Map:
+		b.WriteByte('<')
+		t.Key.write(b)
+		b.WriteByte(',')
+		t.Value.write(b)
+		b.WriteByte('>')
+	case Struct:
+		b.WriteByte('<')
+		for i, f := range t.Fields {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(f.Name)
+			b.WriteByte(':')
+			f.Type.write(b)
+		}
+		b.WriteByte('>')
+	case Union:
+		b.WriteByte('<')
+		for i, m := range t.Members {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			m.write(b)
+		}
+		b.WriteByte('>')
+	}
+}
+
+// Parse parses Hive type string
+func Parse(s string) (*Type, error) {
+	p := &parser{input: s}
+	p.next()
+	t, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok != "" {
+		return nil, p.errorf("unexpected %q after type", p.tok)
+	}
+	return t, nil
+}
+
+// Normalize returns the normalized form of the type string
+func Normalize(s string) (string, error) {
+	t, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return t.String(), nil
+}
+
+// integerDigits is the number of decimal digits of integer types
+var integerDigits = map[Kind]int{
+	TinyInt:  3,
+	SmallInt: 5,
+	Int:      10,
+	BigInt:   19,
+}
+
+// CanWiden returns true if every value of the from type is also a value of the to
+// type, so data written as from type can be read as to type without loss. Struct
+// fields are matched by position and may be added at the end.
+func CanWiden(from, to *Type) bool {
+	if from.String() == to.String() {
+		return true
+	}
+	if to.Kind == String && from.IsPrimitive() && from.Kind != Binary {
+		return true
+	}
+	switch from.Kind {
+	case TinyInt, SmallInt, Int, BigInt:
+		switch to.Kind {
+		case TinyInt, SmallInt, Int, BigInt:
+			return to.Kind >= from.Kind
+		case Float:
+			// Float has 24 bits of mantissa, double has 53
+			return from.Kind <= SmallInt
+		case Double:
+			return from.Kind <= Int
+		case Decimal:
+			return to.Precision-to.Scale >= integerDigits[from.Kind]
+		}
+	case Float:
+		return to.Kind == Double
+	case Decimal:
+		return to.Kind == Decimal && to.Scale >= from.Scale &&
+			to.Precision-to.Scale >= from.Precision-from.Scale
+	case Char, Varchar:
+		return to.Kind == Varchar && to.Length >= from.Length
+	case Date:
+		return to.Kind == Timestamp
+	case Array:
+		return to.Kind == Array && CanWiden(from.Elem, to.Elem)
+	case Map:
+		return to.Kind == Map && CanWiden(from.Key, to.Key) && CanWiden(from.Value, to.Value)
+	case Struct:
+		if to.Kind != Struct || len(to.Fields) < len(from.Fields) {
+			return false
+		}
+		for i, f := range from.Fields {
+			if to.Fields[i].Name != f.Name || !CanWiden(f.Type, to.Fields[i].Type) {
+				return false
+			}
+		}
+		return true
+	case Union:
+		if to.Kind != Union || len(to.Members) != len(from.Members) {
+			return false
+		}
+		for i, m := range from.Members {
+			if !CanWiden(m, to.Members[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// parser is a recursive descent parser over tokens of the type string.
+// Tokens are identifiers, numbers and single punctuation characters.
+type parser struct {
+	input string
+	pos   int    // position after the current token
+	start int    // position of the current token
+	tok   string // current token, empty at the end of input
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// next advances to the next token
+func (p *parser) next() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+	p.start = p.pos
+	if p.pos == len(p.input) {
+		p.tok = ""
+		return
+	}
+	if isIdentChar(p.input[p.pos]) {
+		for p.pos < len(p.input) && isIdentChar(p.input[p.pos]) {
+			p.pos++
+		}
+	} else {
+		p.pos++
+	}
+	p.tok = p.input[p.start:p.pos]
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return p.errorAt(p.start, format, args...)
+}
+
+// errorAt reports error at the given position of the input
+func (p *parser) errorAt(pos int, format string, args ...interface{}) error {
+	return fmt.Errorf("invalid type %q at position %d: %s", p.input, pos,
+		fmt.Sprintf(format, args...))
+}
+
+// expect consumes the expected punctuation token
+func (p *parser) expect(tok string) error {
+	if p.tok != tok {
+		if p.tok == "" {
+			return p.errorf("expected %q, got end of type", tok)
+		}
+		return p.errorf("expected %q, got %q", tok, p.tok)
+	}
+	p.next()
+	return nil
+}
+
+// number consumes a non-negative integer
+func (p *parser) number() (int, error) {
+	n, err := strconv.Atoi(p.tok)
+	if err != nil || n < 0 {
+		return 0, p.errorf("expected number, got %q", p.tok)
+	}
+	p.next()
+	return n, nil
+}
+
+func (p *parser) parseType() (*Type, error) {
+	name := strings.ToLower(p.tok)
+	kind, ok := typeNames[name]
+	if !ok {
+		if name == "" {
+			return nil, p.errorf("missing type")
+		}
+		return nil, p.errorf("unknown type %q", p.tok)
+	}
+	p.next()
+	t := &Type{Kind: kind}
+	var err error
+	switch kind {
+	case Double:
+		// "double precision" is an alias of double
+		if strings.ToLower(p.tok) == "precision" {
+			p.next()
+		}
+	case Char, Varchar:
+		err = p.parseLength(t)
+	case Decimal:
+		err = p.parseDecimal(t)
+	case Array:
+		err = p.parseArray(t)
+	case Map:
+		err = p.parseMap(t)
+	case Struct:
+		err = p.parseStruct(t)
+	case Union:
+		err = p.parseUnion(t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (p *parser) parseLength(t *Type) error {
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	start := p.start
+	n, err := p.number()
+	if err != nil {
+		return err
+	}
+	max := MaxVarcharLength
+	if t.Kind == Char {
+		max = MaxCharLength
+	}
+	if n < 1 || n > max {
+		return p.errorAt(start, "%s length %d is not between 1 and %d", t.Kind, n, max)
+	}
+	t.Length = n
+	return p.expect(")")
+}
+
+func (p *parser) parseDecimal(t *Type) error {
+	t.Precision, t.Scale = DefaultPrecision, DefaultScale
+	if p.tok != "(" {
+		return nil
+	}
+	p.next()
+	start := p.start
+	var err error
+	if t.Precision, err = p.number(); err != nil {
+		return err
+	}
+	t.Scale = 0
+	if p.tok == "," {
+		p.next()
+		if t.Scale, err = p.number(); err != nil {
+			return err
+		}
+	}
+	if t.Precision < 1 || t.Precision > MaxPrecision {
+		return p.errorAt(start, "decimal precision %d is not between 1 and %d", t.Precision, MaxPrecision)
+	}
+	if t.Scale > t.Precision {
+		return p.errorAt(start, "decimal scale %d is greater than precision %d", t.Scale, t.Precision)
+	}
+	return p.expect(")")
+}
+
+func (p *parser) parseArray(t *Type) error {
+	if err := p.expect("<"); err != nil {
+		return err
+	}
+	var err error
+	if t.Elem, err = p.parseType(); err != nil {
+		return err
+	}
+	return p.expect(">")
+}
+
+func (p *parser) parseMap(t *Type) error {
+	if err := p.expect("<"); err != nil {
+		return err
+	}
+	start := p.start
+	var err error
+	if t.Key, err = p.parseType(); err != nil {
+		return err
+	}
+	if !t.Key.IsPrimitive() {
+		return p.errorAt(start, "map key must be a primitive type, got %s", t.Key)
+	}
+	if err = p.expect(","); err != nil {
+		return err
+	}
+	if t.Value, err = p.parseType(); err != nil {
+		return err
+	}
+	return p.expect(">")
+}
+
+func (p *parser) parseStruct(t *Type) error {
+	if err := p.expect("<"); err != nil {
+		return err
+	}
+	names := make(map[string]bool)
+	for {
+		if p.tok == "" || !isIdentChar(p.tok[0]) {
+			return p.errorf("expected field name, got %q", p.tok)
+		}
+		name := strings.ToLower(p.tok)
+		if names[name] {
+			return p.errorf("duplicate field %s", name)
+		}
+		names[name] = true
+		p.next()
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		fieldType, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		t.Fields = append(t.Fields, Field{Name: name, Type: fieldType})
+		if p.tok != "," {
+			break
+		}
+		p.next()
+	}
+	return p.expect(">")
+}
+
+func (p *parser) parseUnion(t *Type) error {
+	if err := p.expect("<"); err != nil {
+		return err
+	}
+	for {
+		member, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		t.Members = append(t.Members, member)
+		if p.tok != "," {
+			break
+		}
+		p.next()
+	}
+	return p.expect(">")
+}
@@ -0,0 +1,116 @@
+package hivetype
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"int", "int"},
+		{"INTEGER", "int"},
+		{" BigInt ", "bigint"},
+		{"double precision", "double"},
+		{"string", "string"},
+		{"char(10)", "char(10)"},
+		{"VARCHAR( 200 )", "varchar(200)"},
+		{"decimal", "decimal(10,0)"},
+		{"dec(5)", "decimal(5,0)"},
+		{"numeric(12, 2)", "decimal(12,2)"},
+		{"array<int>", "array<int>"},
+		{"MAP< STRING, Decimal >", "map<string,decimal(10,0)>"},
+		{"struct<a:int, B:array<string>>", "struct<a:int,b:array<string>>"},
+		{"uniontype<int,string>", "uniontype<int,string>"},
+		{"array<map<string,struct<x:double>>>", "array<map<string,struct<x:double>>>"},
+	}
+	for _, tt := range tests {
+		got, err := Normalize(tt.input)
+		if err != nil {
+			t.Errorf("Normalize(%q): unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string // expected error substring
+	}{
+		{"", "missing type"},
+		{"integr", `unknown type "integr"`},
+		{"int int", `unexpected "int" after type`},
+		{"char", `expected "(", got end of type`},
+		{"char(0)", "char length 0 is not between 1 and 255"},
+		{"char(256)", "char length 256 is not between 1 and 255"},
+		{"varchar(65536)", "varchar length 65536 is not between 1 and 65535"},
+		{"varchar(x)", `expected number, got "x"`},
+		{"decimal(39,0)", "decimal precision 39 is not between 1 and 38"},
+		{"decimal(5,6)", "decimal scale 6 is greater than precision 5"},
+		{"array<int", `expected ">", got end of type`},
+		{"map<int>", `expected ",", got ">"`},
+		{"map<array<int>,int>", "position 4"},
+		{"struct<a int>", `expected ":", got "int"`},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.input)
+		if err == nil {
+			t.Errorf("Parse(%q): expected error", tt.input)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Parse(%q): error %q doesn't contain %q", tt.input, err, tt.err)
+		}
+	}
+}
+
+func TestCanWiden(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"int", "int", true},
+		{"int", "bigint", true},
+		{"bigint", "int", false},
+		{"smallint", "float", true},
+		{"int", "float", false},
+		{"int", "double", true},
+		{"bigint", "double", false},
+		{"float", "double", true},
+		{"int", "decimal(10,0)", true},
+		{"int", "decimal(10,2)", false},
+		{"decimal(10,2)", "decimal(12,2)", true},
+		{"decimal(10,2)", "decimal(10,3)", false},
+		{"char(10)", "varchar(10)", true},
+		{"varchar(10)", "varchar(5)", false},
+		{"date", "timestamp", true},
+		{"int", "string", true},
+		{"binary", "string", false},
+		{"array<int>", "array<bigint>", true},
+		{"map<string,int>", "map<string,string>", true},
+		{"struct<a:int>", "struct<a:bigint,b:string>", true},
+		{"struct<a:int,b:string>", "struct<a:int>", false},
+		{"struct<a:int>", "struct<b:int>", false},
+		{"uniontype<int,string>", "uniontype<bigint,string>", true},
+		{"uniontype<int,string>", "uniontype<int>", false},
+	}
+	for _, tt := range tests {
+		from, err := Parse(tt.from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		to, err := Parse(tt.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := CanWiden(from, to); got != tt.want {
+			t.Errorf("CanWiden(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Service checked by the readiness probe
+const metastoreService = "metastore.Metastore"
+
+// healthHandler serves liveness probe: the proxy is alive as long as it responds.
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("ok\n"))
+}
+
+// readyHandler returns readiness probe which is successful when HMS reports
+// that the metastore service is serving.
+func readyHandler(conn *grpc.ClientConn) http.HandlerFunc {
+	client := healthpb.NewHealthClient(conn)
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+		defer cancel()
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: metastoreService})
+		if err != nil {
+			http.Error(w, "metastore unavailable: "+err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			http.Error(w, "metastore "+resp.Status.String(), http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok\n"))
+	}
+}
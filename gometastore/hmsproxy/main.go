@@ -3,35 +3,222 @@
 package main
 
 import (
+	"crypto/tls"
 	"flag"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	gw "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/akolb1/hmsv2api/gometastore/tlsutil"
+	"github.com/akolb1/hmsv2api/gometastore/tracing"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/imdario/go-ulid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
+// requestIDHeader is the HTTP header with request ID, it is passed to HMS as
+// x-request-id metadata.
+const requestIDHeader = "X-Request-Id"
+
 var (
 	hmsAddr = flag.String("hms", "localhost:10010",
 		"HMS endpoint")
 	proxyAddr = flag.String("proxy", "localhost:8080", "Proxy endpoint")
+
+	// TLS settings for HMS connection
+	hmsTLS        = flag.Bool("hms-tls", false, "Use TLS to connect to HMS")
+	hmsCA         = flag.String("hms-ca", "", "CA certificates to verify HMS, implies -hms-tls")
+	hmsCert       = flag.String("hms-cert", "", "Client certificate for mutual TLS, implies -hms-tls")
+	hmsKey        = flag.String("hms-key", "", "Client key for mutual TLS")
+	hmsServerName = flag.String("hms-servername", "", "Override HMS server name for TLS verification")
+
+	// TLS settings for proxy listener
+	proxyCert = flag.String("cert", "", "Proxy TLS certificate file, enables HTTPS")
+	proxyKey  = flag.String("key", "", "Proxy TLS key file")
+
+	shutdownTimeout = flag.Duration("shutdown-timeout", 30*time.Second,
+		"Time given to in-flight requests to complete on shutdown")
+
+	// Tracing
+	traceExporter = flag.String("trace-exporter", "", "Trace exporter: otlp or file, tracing is disabled if empty")
+	traceEndpoint = flag.String("trace-endpoint", "", "OTLP collector endpoint, e.g. localhost:4317")
+	traceInsecure = flag.Bool("trace-insecure", false, "Use plaintext connection to OTLP collector")
+	traceFile     = flag.String("trace-file", "hmsproxy-traces.json", "Output file for file trace exporter")
+	traceRatio    = flag.Float64("trace-ratio", 1.0, "Fraction of sampled traces")
 )
 
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "hmsproxy",
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests",
+	}, []string{"code", "method"})
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "hmsproxy",
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency",
+	}, []string{"code", "method"})
+	httpInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "hmsproxy",
+		Name:      "http_requests_in_flight",
+		Help:      "Number of HTTP requests being served",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(httpRequests, httpDuration, httpInFlight)
+}
+
+// instrument wraps handler with HTTP metrics
+func instrument(handler http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerInFlight(httpInFlight,
+		promhttp.InstrumentHandlerDuration(httpDuration,
+			promhttp.InstrumentHandlerCounter(httpRequests, handler)))
+}
+
+// withRequestID makes sure that every request has an ID and echoes it in the response.
+func withRequestID(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if id == "" {
+			id = strings.TrimRight(ulid.New().String(), "\u0000")
+			r.Header.Set(requestIDHeader, id)
+		}
+		w.Header().Set(requestIDHeader, id)
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// requestMetadata forwards request ID to HMS
+func requestMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	return metadata.Pairs(strings.ToLower(requestIDHeader), r.Header.Get(requestIDHeader))
+}
+
+// traced wraps handler with HTTP server spans
+func traced(handler http.Handler) http.Handler {
+	if *traceExporter == "" {
+		return handler
+	}
+	return otelhttp.NewHandler(handler, "hmsproxy",
+		otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}))
+}
+
+// dialOptions returns gRPC options for connecting to HMS
+func dialOptions() ([]grpc.DialOption, error) {
+	var opts []grpc.DialOption
+	if *traceExporter != "" {
+		// Client spans propagate trace context to HMS
+		opts = append(opts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	}
+	if !*hmsTLS && *hmsCA == "" && *hmsCert == "" {
+		return append(opts, grpc.WithInsecure()), nil
+	}
+	tlsConfig, err := tlsutil.ClientConfig(*hmsCA, *hmsCert, *hmsKey, *hmsServerName)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))), nil
+}
+
 func run() error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err := gw.RegisterMetastoreHandlerFromEndpoint(ctx, mux, *hmsAddr, opts)
+	shutdownTracing, err := tracing.Init(ctx, tracing.Config{
+		Service:  "hmsproxy",
+		Exporter: *traceExporter,
+		Endpoint: *traceEndpoint,
+		Insecure: *traceInsecure,
+		File:     *traceFile,
+		Ratio:    *traceRatio,
+	})
 	if err != nil {
 		return err
 	}
+	defer shutdownTracing(context.Background())
+
+	mux := runtime.NewServeMux(runtime.WithMetadata(requestMetadata))
+	opts, err := dialOptions()
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(*hmsAddr, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	err = gw.RegisterMetastoreHandler(ctx, mux, conn)
+	if err != nil {
+		return err
+	}
+
+	handler := http.NewServeMux()
+	handler.Handle("/metrics", promhttp.Handler())
+	handler.HandleFunc("/healthz", healthHandler)
+	handler.Handle("/readyz", readyHandler(conn))
+	handler.Handle("/", instrument(withRequestID(traced(mux))))
+
+	server := &http.Server{
+		Addr:    *proxyAddr,
+		Handler: handler,
+	}
+	done := handleShutdown(server)
+
+	if *proxyCert == "" {
+		err = server.ListenAndServe()
+	} else {
+		reloader, rerr := tlsutil.NewReloader(*proxyCert, *proxyKey)
+		if rerr != nil {
+			return rerr
+		}
+		server.TLSConfig = &tls.Config{
+			GetCertificate: reloader.GetCertificate,
+			MinVersion:     tls.VersionTLS12,
+		}
+		// Certificates are provided by TLSConfig
+		err = server.ListenAndServeTLS("", "")
+	}
+	if err != http.ErrServerClosed {
+		return err
+	}
+	// Wait for in-flight requests to complete
+	<-done
+	return nil
+}
 
-	return http.ListenAndServe(*proxyAddr, mux)
+// handleShutdown waits for SIGINT or SIGTERM and shuts down the server gracefully.
+// The returned channel is closed when shutdown is complete.
+func handleShutdown(server *http.Server) <-chan struct{} {
+	done := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		defer close(done)
+		sig := <-signals
+		log.Println("shutting down on", sig)
+		ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			log.Println("graceful shutdown failed:", err)
+			server.Close()
+		}
+	}()
+	return done
 }
 
 func main() {
@@ -0,0 +1,267 @@
+// Audit of metadata mutations
+//
+// Audit interceptors record every call of a mutating RPC after it completes,
+// including its outcome.
+
+package main
+
+import (
+	"context"
+	"path"
+	"sync"
+
+	"github.com/akolb1/hmsv2api/gometastore/audit"
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// mutatingMethods lists RPCs that modify metadata and should be audited
+var mutatingMethods = map[string]bool{
+	"CreateCatalog":     true,
+	"AlterCatalog":      true,
+	"DropCatalog":       true,
+	"CreateDabatase":    true,
+	"AlterDatabase":     true,
+	"DropDatabase":      true,
+	"CreateTable":       true,
+	"AlterTable":        true,
+	"AddColumns":        true,
+	"ReplaceColumns":    true,
+	"ChangeColumn":      true,
+	"DropColumn":        true,
+	"Undrop":            true,
+	"DropTable":         true,
+	"AddPartition":      true,
+	"AddManyPartitions": true,
+	"DropPartitions":    true,
+}
+
+func isMutating(fullMethod string) bool {
+	return mutatingMethods[path.Base(fullMethod)]
+}
+
+// newAuditEvent creates event for the call with caller information filled in.
+func newAuditEvent(ctx context.Context, fullMethod string) *audit.Event {
+	event := &audit.Event{
+		Method:    path.Base(fullMethod),
+		Principal: principalFromContext(ctx),
+		RequestID: requestIDFromContext(ctx),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		event.Client = p.Addr.String()
+	}
+	return event
+}
+
+// Accessors implemented by request messages
+type (
+	catalogGetter   interface{ GetCatalog() string }
+	catInfoGetter   interface{ GetCatalog() *pb.Catalog }
+	nameGetter      interface{ GetName() string }
+	dbIDGetter      interface{ GetDbId() *pb.Id }
+	tableIDGetter   interface{ GetTableId() *pb.Id }
+	idGetter        interface{ GetId() *pb.Id }
+	objectIDGetter  interface{ GetId() string }
+	databaseGetter  interface{ GetDatabase() *pb.Database }
+	tableGetter     interface{ GetTable() *pb.Table }
+	partitionGetter interface{ GetPartition() *pb.Partition }
+	valuesGetter    interface{ GetValues() []*pb.PartitionValues }
+	namesGetter     interface{ GetNames() []string }
+	prefixGetter    interface{ GetPrefix() []string }
+	colsGetter      interface{ GetCols() []*pb.FieldSchema }
+	columnGetter    interface{ GetColumn() *pb.FieldSchema }
+)
+
+func setDatabase(event *audit.Event, id *pb.Id) {
+	if id == nil {
+		return
+	}
+	if id.Name != "" {
+		event.Database = id.Name
+	}
+	if id.Id != "" {
+		event.DatabaseID = id.Id
+	}
+}
+
+func setTable(event *audit.Event, id *pb.Id) {
+	if id == nil {
+		return
+	}
+	if id.Name != "" {
+		event.Table = id.Name
+	}
+	if id.Id != "" {
+		event.TableID = id.Id
+	}
+}
+
+// addRequest fills object identities and parameters from the request.
+func addRequest(event *audit.Event, req interface{}) {
+	r, hasCatalog := req.(catalogGetter)
+	if hasCatalog {
+		event.Catalog = r.GetCatalog()
+	}
+	// Catalog requests identify the catalog by name, column requests use name for
+	// the column
+	if r, ok := req.(nameGetter); ok {
+		if hasCatalog {
+			event.Columns = append(event.Columns, r.GetName())
+		} else {
+			event.Catalog = r.GetName()
+		}
+	}
+	if r, ok := req.(catInfoGetter); ok && r.GetCatalog() != nil {
+		if r.GetCatalog().Name != "" {
+			event.Catalog = r.GetCatalog().Name
+		}
+		event.Parameters = r.GetCatalog().Parameters
+	}
+	_, hasDbID := req.(dbIDGetter)
+	if r, ok := req.(dbIDGetter); ok {
+		setDatabase(event, r.GetDbId())
+	}
+	if r, ok := req.(tableIDGetter); ok {
+		setTable(event, r.GetTableId())
+	}
+	if r, ok := req.(idGetter); ok {
+		// Id refers to a table in table requests and to a database otherwise
+		if hasDbID {
+			setTable(event, r.GetId())
+		} else {
+			setDatabase(event, r.GetId())
+		}
+	}
+	// Undrop refers to a dropped table or database by its ID only
+	if r, ok := req.(objectIDGetter); ok {
+		event.ObjectID = r.GetId()
+	}
+	if r, ok := req.(databaseGetter); ok && r.GetDatabase() != nil {
+		setDatabase(event, r.GetDatabase().Id)
+		event.Parameters = r.GetDatabase().Parameters
+	}
+	if r, ok := req.(tableGetter); ok && r.GetTable() != nil {
+		setTable(event, r.GetTable().Id)
+		event.Parameters = r.GetTable().Parameters
+	}
+	if r, ok := req.(partitionGetter); ok && r.GetPartition() != nil {
+		event.Partitions = append(event.Partitions, r.GetPartition().Values)
+		event.Parameters = r.GetPartition().Parameters
+	}
+	if r, ok := req.(colsGetter); ok {
+		for _, col := range r.GetCols() {
+			event.Columns = append(event.Columns, col.GetName())
+		}
+	}
+	// Renamed column is recorded under both names
+	if r, ok := req.(columnGetter); ok && r.GetColumn() != nil {
+		if name := r.GetColumn().Name; name != "" && (len(event.Columns) == 0 || event.Columns[0] != name) {
+			event.Columns = append(event.Columns, name)
+		}
+	}
+	if r, ok := req.(valuesGetter); ok {
+		for _, v := range r.GetValues() {
+			event.Partitions = append(event.Partitions, v.GetValue())
+		}
+	}
+	if r, ok := req.(namesGetter); ok {
+		event.PartNames = r.GetNames()
+	}
+	if r, ok := req.(prefixGetter); ok {
+		event.PartPrefix = r.GetPrefix()
+	}
+}
+
+// addResult sets outcome of the call and identities assigned by the server.
+func addResult(event *audit.Event, resp interface{}, err error) {
+	if err != nil {
+		event.Status = status.Code(err).String()
+		event.Error = status.Convert(err).Message()
+		return
+	}
+	if r, ok := resp.(databaseGetter); ok && r.GetDatabase() != nil {
+		setDatabase(event, r.GetDatabase().Id)
+	}
+	if r, ok := resp.(tableGetter); ok && r.GetTable() != nil {
+		setTable(event, r.GetTable().Id)
+	}
+	st := responseStatus(resp)
+	if st == nil {
+		event.Status = pb.RequestStatus_STATUS_OK.String()
+		return
+	}
+	event.Status = st.Status.String()
+	event.Error = st.Error
+}
+
+func auditUnaryInterceptor(auditor *audit.Auditor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if !isMutating(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		event := newAuditEvent(ctx, info.FullMethod)
+		addRequest(event, req)
+		resp, err := handler(ctx, req)
+		addResult(event, resp, err)
+		auditor.Log(event)
+		return resp, err
+	}
+}
+
+// auditStream records identities from the first received request, counts requests
+// and remembers the first failure reported in responses.
+type auditStream struct {
+	grpc.ServerStream
+	mu     sync.Mutex
+	event  *audit.Event
+	failed *pb.RequestStatus
+}
+
+func (s *auditStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.mu.Lock()
+		if s.event.Count == 0 {
+			addRequest(s.event, m)
+		} else if r, ok := m.(partitionGetter); ok && r.GetPartition() != nil {
+			s.event.Partitions = append(s.event.Partitions, r.GetPartition().Values)
+		}
+		s.event.Count++
+		s.mu.Unlock()
+	}
+	return err
+}
+
+func (s *auditStream) SendMsg(m interface{}) error {
+	if st := responseStatus(m); st != nil && st.Status != pb.RequestStatus_STATUS_OK {
+		s.mu.Lock()
+		if s.failed == nil {
+			s.failed = st
+		}
+		s.mu.Unlock()
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func auditStreamInterceptor(auditor *audit.Auditor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if !isMutating(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		s := &auditStream{ServerStream: stream, event: newAuditEvent(stream.Context(), info.FullMethod)}
+		err := handler(srv, s)
+		s.mu.Lock()
+		var resp interface{}
+		if s.failed != nil {
+			resp = s.failed
+		}
+		addResult(s.event, resp, err)
+		s.mu.Unlock()
+		auditor.Log(s.event)
+		return err
+	}
+}
@@ -0,0 +1,217 @@
+// Backup
+//
+// Bolt read transactions see a consistent view of the database, so a snapshot can
+// be written while the server keeps serving requests. Snapshots are available as
+//
+//   - Backup RPC streaming the database file in chunks
+//   - /backup endpoint of the admin HTTP listener
+//   - periodic local snapshots with retention (-snapshot-dir)
+//   - snapshot command working with the database file directly
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultBackupChunkSize = 1 << 20
+	// Larger requested chunks are capped, so that chunks with their message framing
+	// fit the 4 MiB default receive limit of gRPC clients.
+	maxBackupChunkSize = 4<<20 - 64<<10
+	snapshotTimeFormat = "20060102T150405Z"
+)
+
+// chunkWriter sends data written to it as a sequence of BackupChunk messages.
+type chunkWriter struct {
+	stream pb.Metastore_BackupServer
+	buf    []byte
+	size   int64
+	txID   uint64
+	sent   bool
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		l := cap(w.buf) - len(w.buf)
+		if l > len(p) {
+			l = len(p)
+		}
+		w.buf = append(w.buf, p[:l]...)
+		p = p[l:]
+		if len(w.buf) == cap(w.buf) {
+			if err := w.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// flush sends buffered data. The first chunk is always sent, even if it is empty,
+// since it carries snapshot size and transaction ID.
+func (w *chunkWriter) flush() error {
+	if len(w.buf) == 0 && w.sent {
+		return nil
+	}
+	chunk := &pb.BackupChunk{Data: w.buf}
+	if !w.sent {
+		chunk.Size = w.size
+		chunk.TxId = w.txID
+	}
+	if err := w.stream.Send(chunk); err != nil {
+		return err
+	}
+	w.sent = true
+	w.buf = make([]byte, 0, cap(w.buf))
+	return nil
+}
+
+// Backup streams consistent snapshot of the database.
+func (s *metastoreServer) Backup(req *pb.BackupRequest, stream pb.Metastore_BackupServer) error {
+	logger := requestLogger(stream.Context())
+	logger.Debug("Backup: ", req)
+	chunkSize := int(req.ChunkSize)
+	if chunkSize == 0 {
+		chunkSize = defaultBackupChunkSize
+	}
+	if chunkSize > maxBackupChunkSize {
+		chunkSize = maxBackupChunkSize
+	}
+	err := s.view(stream.Context(), func(tx *bolt.Tx) error {
+		w := &chunkWriter{
+			stream: stream,
+			buf:    make([]byte, 0, chunkSize),
+			size:   tx.Size(),
+			txID:   uint64(tx.ID()),
+		}
+		if _, err := tx.WriteTo(w); err != nil {
+			return err
+		}
+		return w.flush()
+	})
+	if err != nil {
+		logger.WithError(err).Warn("failed to send backup")
+		return err
+	}
+	return nil
+}
+
+// backupHandler serves database snapshot over HTTP.
+func backupHandler(db *bolt.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := db.View(func(tx *bolt.Tx) error {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Length", strconv.FormatInt(tx.Size(), 10))
+			w.Header().Set("Content-Disposition",
+				fmt.Sprintf("attachment; filename=%q", filepath.Base(db.Path())))
+			w.Header().Set("X-Bolt-Txid", strconv.Itoa(tx.ID()))
+			_, err := tx.WriteTo(w)
+			return err
+		})
+		if err != nil {
+			// Headers are already sent, the client sees truncated response
+			log.WithError(err).Warn("failed to send backup")
+		}
+	}
+}
+
+// writeSnapshot writes consistent copy of the database to the file.
+// The copy is written to a temporary file first, so the file is never left incomplete.
+func writeSnapshot(db *bolt.DB, path string) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(f)
+		return err
+	})
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// snapshotPrefix returns prefix of snapshot file names for the database
+func snapshotPrefix(db *bolt.DB) string {
+	name := filepath.Base(db.Path())
+	return strings.TrimSuffix(name, filepath.Ext(name)) + "-"
+}
+
+// takeSnapshot writes timestamped snapshot to dir and removes old ones,
+// keeping the most recent keep snapshots.
+func takeSnapshot(db *bolt.DB, dir string, keep int) error {
+	prefix := snapshotPrefix(db)
+	name := prefix + time.Now().UTC().Format(snapshotTimeFormat) + ".db"
+	if err := writeSnapshot(db, filepath.Join(dir, name)); err != nil {
+		return err
+	}
+	log.WithField("file", name).Info("snapshot created")
+	if keep <= 0 {
+		return nil
+	}
+	snapshots, err := filepath.Glob(filepath.Join(dir, prefix+"*.db"))
+	if err != nil {
+		return err
+	}
+	// Timestamps sort in chronological order
+	sort.Strings(snapshots)
+	for len(snapshots) > keep {
+		if err := os.Remove(snapshots[0]); err != nil {
+			return err
+		}
+		snapshots = snapshots[1:]
+	}
+	return nil
+}
+
+// runSnapshots takes snapshots periodically until stop is closed.
+func runSnapshots(db *bolt.DB, dir string, interval time.Duration, keep int,
+	stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := takeSnapshot(db, dir, keep); err != nil {
+				log.WithError(err).Error("failed to create snapshot")
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// snapshotCommand writes snapshot of the database to the file given as argument.
+func snapshotCommand(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: snapshot <file>")
+	}
+	db, err := openDB(true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return writeSnapshot(db, args[0])
+}
@@ -0,0 +1,307 @@
+// Catalog operations
+//
+// Every catalog is a root bucket which contains the catalog record under the CATALOG
+// key along with BYNAME, BYID and DB buckets for its databases and the IDX bucket
+// described in idindex.go. Catalogs must be
+// created explicitly before databases can be added to them.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const catalogHdr = "CATALOG" // Catalog record in the catalog bucket
+
+// notFoundError is reported to clients as STATUS_NOTFOUND
+type notFoundError string
+
+func (e notFoundError) Error() string {
+	return string(e)
+}
+
+// invalidError is reported to clients as STATUS_INVALID
+type invalidError string
+
+func (e invalidError) Error() string {
+	return string(e)
+}
+
+// conflictError is reported to clients as STATUS_CONFLICT
+type conflictError string
+
+func (e conflictError) Error() string {
+	return string(e)
+}
+
+// errorStatus converts error to RequestStatus
+func errorStatus(err error) *pb.RequestStatus {
+	switch err.(type) {
+	case notFoundError:
+		return &pb.RequestStatus{Status: pb.RequestStatus_STATUS_NOTFOUND, Error: err.Error()}
+	case invalidError:
+		return &pb.RequestStatus{Status: pb.RequestStatus_STATUS_INVALID, Error: err.Error()}
+	case conflictError, schemaConflictError:
+		return &pb.RequestStatus{Status: pb.RequestStatus_STATUS_CONFLICT, Error: err.Error()}
+	}
+	return &pb.RequestStatus{Status: pb.RequestStatus_STATUS_ERROR, Error: err.Error()}
+}
+
+// streamError converts error returned by streaming call to gRPC status
+func streamError(err error) error {
+	switch err.(type) {
+	case notFoundError:
+		return status.Error(codes.NotFound, err.Error())
+	case invalidError:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case conflictError:
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return err
+}
+
+// getCatalogBucket returns bucket of an existing catalog.
+func getCatalogBucket(tx *bolt.Tx, catalog string) (*bolt.Bucket, error) {
+	if catalog == metaBucket {
+		return nil, notFoundError(fmt.Sprintf("catalog %s doesn't exist", catalog))
+	}
+	catBucket := tx.Bucket([]byte(catalog))
+	if catBucket == nil {
+		return nil, notFoundError(fmt.Sprintf("catalog %s doesn't exist", catalog))
+	}
+	return catBucket, nil
+}
+
+// getCatalog returns catalog record.
+func getCatalog(tx *bolt.Tx, catalog string) (*pb.Catalog, error) {
+	catBucket, err := getCatalogBucket(tx, catalog)
+	if err != nil {
+		return nil, err
+	}
+	return decodeCatalog(catalog, catBucket)
+}
+
+func decodeCatalog(catalog string, catBucket *bolt.Bucket) (*pb.Catalog, error) {
+	data := catBucket.Get([]byte(catalogHdr))
+	if data == nil {
+		return nil, fmt.Errorf("corrupt catalog %s: no %s record", catalog, catalogHdr)
+	}
+	var cat pb.Catalog
+	if err := proto.Unmarshal(data, &cat); err != nil {
+		return nil, fmt.Errorf("corrupt catalog %s: can't decode catalog record: %v", catalog, err)
+	}
+	return &cat, nil
+}
+
+func putCatalog(catBucket *bolt.Bucket, cat *pb.Catalog) error {
+	data, err := proto.Marshal(cat)
+	if err != nil {
+		return err
+	}
+	return catBucket.Put([]byte(catalogHdr), data)
+}
+
+// createCatalog creates catalog bucket with its record and database buckets.
+// It fails if the catalog already exists.
+func createCatalog(tx *bolt.Tx, cat *pb.Catalog) (*bolt.Bucket, error) {
+	if err := validCatalog(cat.Name); err != nil {
+		return nil, err
+	}
+	if tx.Bucket([]byte(cat.Name)) != nil {
+		return nil, conflictError(fmt.Sprintf("catalog %s already exists", cat.Name))
+	}
+	catBucket, err := tx.CreateBucket([]byte(cat.Name))
+	if err != nil {
+		return nil, err
+	}
+	if err = initCatalog(catBucket, cat); err != nil {
+		return nil, err
+	}
+	return catBucket, nil
+}
+
+// initCatalog stores catalog record and creates database and index buckets.
+func initCatalog(catBucket *bolt.Bucket, cat *pb.Catalog) error {
+	for _, name := range []string{bynameHdr, byIDHdr, dbHdr, idxHdr} {
+		if _, err := catBucket.CreateBucketIfNotExists([]byte(name)); err != nil {
+			return err
+		}
+	}
+	return putCatalog(catBucket, cat)
+}
+
+// ensureCatalog creates catalog with the given name unless it exists.
+func ensureCatalog(db *bolt.DB, name string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket([]byte(name)) != nil {
+			return nil
+		}
+		log.WithField("catalog", name).Info("creating default catalog")
+		_, err := createCatalog(tx, &pb.Catalog{Name: name})
+		return err
+	})
+}
+
+func (s *metastoreServer) CreateCatalog(c context.Context,
+	req *pb.CreateCatalogRequest) (*pb.GetCatalogResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("CreateCatalog: ", req)
+	cat := req.Catalog
+	if cat == nil {
+		return nil, fmt.Errorf("missing catalog info")
+	}
+	if err := validCatalog(cat.Name); err != nil {
+		return nil, err
+	}
+
+	err := s.update(c, func(tx *bolt.Tx) error {
+		_, err := createCatalog(tx, cat)
+		return err
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to create catalog")
+		return &pb.GetCatalogResponse{Status: errorStatus(err)}, nil
+	}
+
+	return &pb.GetCatalogResponse{
+		Status:  &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK},
+		Catalog: cat,
+	}, nil
+}
+
+func (s *metastoreServer) GetCatalog(c context.Context,
+	req *pb.GetCatalogRequest) (*pb.GetCatalogResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("GetCatalog: ", req)
+	if req.Name == "" {
+		return nil, fmt.Errorf("missing catalog name")
+	}
+
+	var cat *pb.Catalog
+	err := s.view(c, func(tx *bolt.Tx) error {
+		var err error
+		cat, err = getCatalog(tx, req.Name)
+		return err
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to get catalog")
+		return &pb.GetCatalogResponse{Status: errorStatus(err)}, nil
+	}
+
+	return &pb.GetCatalogResponse{
+		Status:  &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK},
+		Catalog: cat,
+	}, nil
+}
+
+func (s *metastoreServer) ListCatalogs(req *pb.ListCatalogsRequest,
+	stream pb.Metastore_ListCatalogsServer) error {
+	logger := requestLogger(stream.Context())
+	logger.Debug("ListCatalogs: ", req)
+
+	err := s.view(stream.Context(), func(tx *bolt.Tx) error {
+		return forEachCatalog(tx, func(name []byte, catBucket *bolt.Bucket) error {
+			cat, err := decodeCatalog(string(name), catBucket)
+			if err != nil {
+				logger.WithError(err).Warn("skipping catalog")
+				return nil
+			}
+			return stream.Send(cat)
+		})
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to list catalogs")
+		return err
+	}
+
+	return nil
+}
+
+func (s *metastoreServer) AlterCatalog(c context.Context,
+	req *pb.AlterCatalogRequest) (*pb.GetCatalogResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("AlterCatalog: ", req)
+	if req.Name == "" {
+		return nil, fmt.Errorf("missing catalog name")
+	}
+	if req.Catalog == nil {
+		return nil, fmt.Errorf("missing catalog info")
+	}
+	if req.Catalog.Name != "" && req.Catalog.Name != req.Name {
+		return nil, fmt.Errorf("catalog %s can't be renamed", req.Name)
+	}
+
+	var cat *pb.Catalog
+	err := s.update(c, func(tx *bolt.Tx) error {
+		catBucket, err := getCatalogBucket(tx, req.Name)
+		if err != nil {
+			return err
+		}
+		if cat, err = decodeCatalog(req.Name, catBucket); err != nil {
+			return err
+		}
+		if req.Catalog.Description != "" {
+			cat.Description = req.Catalog.Description
+		}
+		if req.Catalog.Location != "" {
+			cat.Location = req.Catalog.Location
+		}
+		cat.Parameters = req.Catalog.Parameters
+		return putCatalog(catBucket, cat)
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to alter catalog")
+		return &pb.GetCatalogResponse{Status: errorStatus(err)}, nil
+	}
+
+	return &pb.GetCatalogResponse{
+		Status:  &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK},
+		Catalog: cat,
+	}, nil
+}
+
+func (s *metastoreServer) DropCatalog(c context.Context,
+	req *pb.DropCatalogRequest) (*pb.RequestStatus, error) {
+	logger := requestLogger(c)
+	logger.Debug("DropCatalog: ", req)
+	if req.Name == "" {
+		return nil, fmt.Errorf("missing catalog name")
+	}
+
+	var busy bool
+	err := s.update(c, func(tx *bolt.Tx) error {
+		catBucket, err := getCatalogBucket(tx, req.Name)
+		if err != nil {
+			return err
+		}
+		if idMap := catBucket.Bucket([]byte(byIDHdr)); idMap != nil {
+			if k, _ := idMap.Cursor().First(); k != nil {
+				busy = true
+				return fmt.Errorf("catalog %s is not empty", req.Name)
+			}
+		}
+		return tx.DeleteBucket([]byte(req.Name))
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to drop catalog")
+		if busy {
+			return &pb.RequestStatus{Status: pb.RequestStatus_STATUS_BUSY, Error: err.Error()}, nil
+		}
+		return errorStatus(err), nil
+	}
+
+	return &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK}, nil
+}
@@ -0,0 +1,125 @@
+// Column types
+//
+// Types of table columns and partition keys are parsed with the hivetype package
+// and stored in the normalized form, so that e.g. "INT" and "integer" are stored as
+// "int". Partition keys must have primitive types. Tables created before types were
+// checked may have types which don't parse, values of such partition keys are
+// treated as strings.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/akolb1/hmsv2api/gometastore/hivetype"
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+)
+
+// normalizeColumns checks names and types of table columns and partition keys and
+// replaces types with their normalized form.
+func normalizeColumns(table *pb.Table) error {
+	names := make(map[string]string)
+	for _, col := range table.GetSd().GetCols() {
+		if _, err := normalizeColumn(names, col, "column"); err != nil {
+			return err
+		}
+	}
+	for _, key := range table.PartitionKeys {
+		typ, err := normalizeColumn(names, key, "partition key")
+		if err != nil {
+			return err
+		}
+		if !typ.IsPrimitive() {
+			return invalidError(fmt.Sprintf("partition key %s has non-primitive type %s",
+				key.Name, key.Type))
+		}
+	}
+	return nil
+}
+
+// normalizeChangedColumns checks names and types of table columns which are not
+// columns of the old table and replaces their types with the normalized form. Names
+// of unchanged columns and partition keys are only checked for conflicts.
+func normalizeChangedColumns(old, table *pb.Table) error {
+	type column struct{ name, typ string }
+	unchanged := make(map[column]bool)
+	for _, col := range old.GetSd().GetCols() {
+		unchanged[column{col.GetName(), col.GetType()}] = true
+	}
+	names := make(map[string]string)
+	for _, key := range table.PartitionKeys {
+		names[strings.ToLower(key.Name)] = "partition key"
+	}
+	var changed []*pb.FieldSchema
+	for _, col := range table.GetSd().GetCols() {
+		if col != nil && unchanged[column{col.Name, col.Type}] {
+			names[strings.ToLower(col.Name)] = "column"
+		} else {
+			changed = append(changed, col)
+		}
+	}
+	for _, col := range changed {
+		if _, err := normalizeColumn(names, col, "column"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeColumn checks that the column name is not in names yet and replaces its
+// type with the normalized form. The name is added to names.
+func normalizeColumn(names map[string]string, col *pb.FieldSchema,
+	what string) (*hivetype.Type, error) {
+	if col == nil || col.Name == "" {
+		return nil, invalidError(fmt.Sprintf("missing %s name", what))
+	}
+	name := strings.ToLower(col.Name)
+	if other, ok := names[name]; ok {
+		return nil, invalidError(fmt.Sprintf("duplicate %s %s, already used as %s",
+			what, col.Name, other))
+	}
+	names[name] = what
+	typ, err := hivetype.Parse(col.Type)
+	if err != nil {
+		return nil, invalidError(fmt.Sprintf("%s %s: %v", what, col.Name, err))
+	}
+	col.Type = typ.String()
+	return typ, nil
+}
+
+// partitionKeyType returns the parsed type of the partition key, nil if the type
+// doesn't parse.
+func partitionKeyType(typ string) *hivetype.Type {
+	t, err := hivetype.Parse(typ)
+	if err != nil {
+		return nil
+	}
+	return t
+}
+
+// samePartitionKeys returns true if both lists have the same partition key names and
+// types
+func samePartitionKeys(keys, other []*pb.FieldSchema) bool {
+	if len(keys) != len(other) {
+		return false
+	}
+	for i, key := range keys {
+		if other[i] == nil || !strings.EqualFold(key.Name, other[i].Name) {
+			return false
+		}
+		if normalizedType(key.Type) != normalizedType(other[i].Type) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizedType returns the normalized type, types which don't parse are returned
+// as is
+func normalizedType(typ string) string {
+	if normalized, err := hivetype.Normalize(typ); err == nil {
+		return normalized
+	}
+	return typ
+}
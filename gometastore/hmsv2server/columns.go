@@ -0,0 +1,180 @@
+// Column operations
+//
+// Column requests change columns of the table Sd without sending the whole table.
+// Changes are stored the same way as AlterTable stores them, so they are checked
+// against the table compatibility mode and create new schema versions. Column names
+// are matched case-insensitively like in Hive.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+)
+
+// columnChange returns new columns of the table given the current ones
+type columnChange func(cols []*pb.FieldSchema) ([]*pb.FieldSchema, error)
+
+// findColumn returns position of the column with the name, -1 if there is none
+func findColumn(cols []*pb.FieldSchema, name string) int {
+	for i, col := range cols {
+		if strings.EqualFold(col.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// alterColumns applies the change to columns of the table and stores the table
+func (s *metastoreServer) alterColumns(c context.Context, catalog string, dbID *pb.Id,
+	id *pb.Id, cascade bool, change columnChange) (*pb.AlterTableResponse, error) {
+	logger := requestLogger(c)
+	if id == nil {
+		return nil, fmt.Errorf("missing identity info")
+	}
+	if dbID == nil {
+		return nil, fmt.Errorf("missing DB info")
+	}
+	if catalog == "" {
+		return nil, fmt.Errorf("missing catalog")
+	}
+	dbName := dbID.Name
+	if dbName == "" {
+		return nil, fmt.Errorf("missing database name")
+	}
+	tableName := id.Name
+	if tableName == "" {
+		return nil, fmt.Errorf("missing table name")
+	}
+
+	var table *pb.Table
+	err := s.update(c, func(tx *bolt.Tx) error {
+		dbBucket, err := getDatabaseBucket(tx, catalog, dbID)
+		if err != nil {
+			return err
+		}
+		old, tableID, err := getTable(dbBucket, catalog, dbName, tableName)
+		if err != nil {
+			return err
+		}
+		table = proto.Clone(old).(*pb.Table)
+		if table.Sd == nil {
+			table.Sd = new(pb.StorageDescriptor)
+		}
+		if table.Sd.Cols, err = change(table.Sd.Cols); err != nil {
+			return err
+		}
+		table.LastModifiedTime = nowMillis()
+		table.ModifiedBy = principalFromContext(c)
+		return alterTable(dbBucket, tableID, old, table, cascade)
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to alter columns")
+		response := &pb.AlterTableResponse{Status: errorStatus(err)}
+		if conflict, ok := err.(schemaConflictError); ok {
+			response.Incompatibilities = conflict
+		}
+		return response, nil
+	}
+
+	return &pb.AlterTableResponse{
+		Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK},
+		Table:  table,
+	}, nil
+}
+
+func (s *metastoreServer) AddColumns(c context.Context,
+	req *pb.AddColumnsRequest) (*pb.AlterTableResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("AddColumns: ", req)
+	if len(req.Cols) == 0 {
+		return nil, fmt.Errorf("missing columns")
+	}
+	return s.alterColumns(c, req.Catalog, req.DbId, req.Id, req.Cascade,
+		func(cols []*pb.FieldSchema) ([]*pb.FieldSchema, error) {
+			return append(cols, req.Cols...), nil
+		})
+}
+
+func (s *metastoreServer) ReplaceColumns(c context.Context,
+	req *pb.ReplaceColumnsRequest) (*pb.AlterTableResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("ReplaceColumns: ", req)
+	if len(req.Cols) == 0 {
+		return nil, fmt.Errorf("missing columns")
+	}
+	return s.alterColumns(c, req.Catalog, req.DbId, req.Id, req.Cascade,
+		func(cols []*pb.FieldSchema) ([]*pb.FieldSchema, error) {
+			return req.Cols, nil
+		})
+}
+
+func (s *metastoreServer) ChangeColumn(c context.Context,
+	req *pb.ChangeColumnRequest) (*pb.AlterTableResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("ChangeColumn: ", req)
+	if req.Name == "" {
+		return nil, fmt.Errorf("missing column name")
+	}
+	if req.Column == nil {
+		return nil, fmt.Errorf("missing column")
+	}
+	if req.First && req.After != "" {
+		return nil, fmt.Errorf("first and after can't be both set")
+	}
+	return s.alterColumns(c, req.Catalog, req.DbId, req.Id, req.Cascade,
+		func(cols []*pb.FieldSchema) ([]*pb.FieldSchema, error) {
+			pos := findColumn(cols, req.Name)
+			if pos < 0 {
+				return nil, notFoundError(fmt.Sprintf("column %s does not exist", req.Name))
+			}
+			col := proto.Clone(cols[pos]).(*pb.FieldSchema)
+			if req.Column.Name != "" {
+				col.Name = req.Column.Name
+			}
+			if req.Column.Type != "" {
+				col.Type = req.Column.Type
+			}
+			col.Comment = req.Column.Comment
+			if !req.First && req.After == "" {
+				cols[pos] = col
+				return cols, nil
+			}
+			cols = append(cols[:pos], cols[pos+1:]...)
+			pos = 0
+			if req.After != "" {
+				if pos = findColumn(cols, req.After); pos < 0 {
+					return nil, notFoundError(fmt.Sprintf("column %s does not exist", req.After))
+				}
+				pos++
+			}
+			cols = append(cols[:pos], append([]*pb.FieldSchema{col}, cols[pos:]...)...)
+			return cols, nil
+		})
+}
+
+func (s *metastoreServer) DropColumn(c context.Context,
+	req *pb.DropColumnRequest) (*pb.AlterTableResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("DropColumn: ", req)
+	if req.Name == "" {
+		return nil, fmt.Errorf("missing column name")
+	}
+	return s.alterColumns(c, req.Catalog, req.DbId, req.Id, req.Cascade,
+		func(cols []*pb.FieldSchema) ([]*pb.FieldSchema, error) {
+			pos := findColumn(cols, req.Name)
+			if pos < 0 {
+				return nil, notFoundError(fmt.Sprintf("column %s does not exist", req.Name))
+			}
+			if len(cols) == 1 {
+				return nil, invalidError(fmt.Sprintf("can't drop the only column %s", req.Name))
+			}
+			return append(cols[:pos], cols[pos+1:]...), nil
+		})
+}
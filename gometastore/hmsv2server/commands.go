@@ -0,0 +1,53 @@
+// Commands
+//
+// Besides running the server, hmsv2server supports administrative commands which
+// work with the database file directly. The server must not be running, and the
+// global flags (-dbname, -bolt-timeout, ...) apply:
+//
+//   hmsv2server [flags] <command> [arguments]
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+type command struct {
+	usage string // Arguments and description
+	run   func(args []string) error
+}
+
+var commands = map[string]command{
+	"snapshot": {"<file> - write consistent copy of the database to file", snapshotCommand},
+	"export":   {"[options] - export catalogs as JSON lines", exportCommand},
+	"import":   {"[options] [file] - import JSON lines produced by export", importCommand},
+	"fsck":     {"[-repair] - check consistency of the database", fsckCommand},
+	"migrate":  {"- upgrade database layout to the current version", migrateCommand},
+}
+
+// usage prints flags and commands
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s:\n", os.Args[0])
+	flag.PrintDefaults()
+	fmt.Fprintf(flag.CommandLine.Output(), "Commands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(flag.CommandLine.Output(), "  %s %s\n", name, commands[name].usage)
+	}
+}
+
+// runCommand runs the command given by the first argument.
+func runCommand(args []string) error {
+	cmd, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %s", args[0])
+	}
+	return cmd.run(args[1:])
+}
@@ -0,0 +1,146 @@
+// Schema compatibility
+//
+// Tables choose how their columns may change with the "schema.compatibility" table
+// parameter:
+//
+//   - none: any change is allowed (default)
+//   - backward: readers using the new columns can read existing data
+//   - forward: readers using the old columns can read new data
+//   - full: both backward and forward
+//
+// Hive reads many formats by column position, so in every mode except none existing
+// columns must keep their positions and can't be dropped, new columns may only be
+// added after them. Column types may be widened in backward mode, narrowed in forward
+// mode and must stay the same in full mode. Comments may always change.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/akolb1/hmsv2api/gometastore/hivetype"
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/golang/protobuf/proto"
+)
+
+const compatibilityParam = "schema.compatibility"
+
+// Schema compatibility modes
+const (
+	compatNone     = "none"
+	compatBackward = "backward"
+	compatForward  = "forward"
+	compatFull     = "full"
+)
+
+// schemaConflictError is reported to clients as STATUS_CONFLICT along with the list
+// of incompatible column changes.
+type schemaConflictError []*pb.SchemaIncompatibility
+
+func (e schemaConflictError) Error() string {
+	messages := make([]string, len(e))
+	for i, problem := range e {
+		messages[i] = problem.Message
+	}
+	return "incompatible schema change: " + strings.Join(messages, "; ")
+}
+
+// compatibilityMode returns schema compatibility mode of the table
+func compatibilityMode(table *pb.Table) (string, error) {
+	mode, ok := table.Parameters[compatibilityParam]
+	if !ok {
+		return compatNone, nil
+	}
+	switch mode = strings.ToLower(mode); mode {
+	case compatNone, compatBackward, compatForward, compatFull:
+		return mode, nil
+	}
+	return "", invalidError(fmt.Sprintf("invalid %s %q, expected one of none, backward, forward, full",
+		compatibilityParam, mode))
+}
+
+// equalColumns returns true if both lists have the same columns
+func equalColumns(a, b []*pb.FieldSchema) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// canReadType returns true if values written as the from type can be read as the to
+// type. Types which don't parse must be equal.
+func canReadType(from, to string) bool {
+	fromType, err := hivetype.Parse(from)
+	if err != nil {
+		return from == to
+	}
+	toType, err := hivetype.Parse(to)
+	if err != nil {
+		return false
+	}
+	return hivetype.CanWiden(fromType, toType)
+}
+
+// checkCompatibility returns changes from old to new columns which break the
+// compatibility mode
+func checkCompatibility(mode string, oldCols, newCols []*pb.FieldSchema) []*pb.SchemaIncompatibility {
+	if mode == compatNone {
+		return nil
+	}
+	positions := make(map[string]int, len(newCols))
+	for i, col := range newCols {
+		positions[strings.ToLower(col.Name)] = i
+	}
+	var problems []*pb.SchemaIncompatibility
+	kept := 0 // number of old columns found in new columns so far
+	for _, col := range oldCols {
+		pos, ok := positions[strings.ToLower(col.Name)]
+		if !ok {
+			problems = append(problems, &pb.SchemaIncompatibility{
+				Kind:    pb.SchemaIncompatibility_INCOMPATIBLE_DROPPED,
+				Column:  col.Name,
+				OldType: col.Type,
+				Message: fmt.Sprintf("column %s is dropped", col.Name),
+			})
+			continue
+		}
+		newCol := newCols[pos]
+		if pos != kept {
+			problems = append(problems, &pb.SchemaIncompatibility{
+				Kind:    pb.SchemaIncompatibility_INCOMPATIBLE_MOVED,
+				Column:  col.Name,
+				OldType: col.Type,
+				NewType: newCol.Type,
+				Message: fmt.Sprintf("column %s is moved to position %d, expected %d",
+					col.Name, pos+1, kept+1),
+			})
+		}
+		kept++
+		readable := true
+		switch mode {
+		case compatBackward:
+			readable = canReadType(col.Type, newCol.Type)
+		case compatForward:
+			readable = canReadType(newCol.Type, col.Type)
+		case compatFull:
+			readable = canReadType(col.Type, newCol.Type) && canReadType(newCol.Type, col.Type)
+		}
+		if !readable {
+			problems = append(problems, &pb.SchemaIncompatibility{
+				Kind:    pb.SchemaIncompatibility_INCOMPATIBLE_TYPE,
+				Column:  col.Name,
+				OldType: col.Type,
+				NewType: newCol.Type,
+				Message: fmt.Sprintf("type of column %s is changed from %s to %s in %s mode",
+					col.Name, col.Type, newCol.Type, mode),
+			})
+		}
+	}
+	return problems
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+)
+
+// testColumns returns columns with the given names and types
+func testColumns(cols ...string) []*pb.FieldSchema {
+	var result []*pb.FieldSchema
+	for i := 0; i < len(cols); i += 2 {
+		result = append(result, &pb.FieldSchema{Name: cols[i], Type: cols[i+1]})
+	}
+	return result
+}
+
+func TestCompatibilityMode(t *testing.T) {
+	tests := []struct {
+		params  map[string]string
+		want    string
+		wantErr bool
+	}{
+		{nil, compatNone, false},
+		{map[string]string{compatibilityParam: "none"}, compatNone, false},
+		{map[string]string{compatibilityParam: "BACKWARD"}, compatBackward, false},
+		{map[string]string{compatibilityParam: "forward"}, compatForward, false},
+		{map[string]string{compatibilityParam: "Full"}, compatFull, false},
+		{map[string]string{compatibilityParam: ""}, "", true},
+		{map[string]string{compatibilityParam: "transitive"}, "", true},
+	}
+	for _, tt := range tests {
+		mode, err := compatibilityMode(&pb.Table{Parameters: tt.params})
+		if tt.wantErr {
+			if _, ok := err.(invalidError); !ok {
+				t.Errorf("%v: expected invalidError, got %v", tt.params, err)
+			}
+			continue
+		}
+		if err != nil || mode != tt.want {
+			t.Errorf("%v: mode %q (%v), want %q", tt.params, mode, err, tt.want)
+		}
+	}
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	const (
+		dropped = pb.SchemaIncompatibility_INCOMPATIBLE_DROPPED
+		moved   = pb.SchemaIncompatibility_INCOMPATIBLE_MOVED
+		typ     = pb.SchemaIncompatibility_INCOMPATIBLE_TYPE
+	)
+	oldCols := testColumns("a", "int", "b", "string")
+	tests := []struct {
+		name    string
+		newCols []*pb.FieldSchema
+		want    map[string][]pb.SchemaIncompatibility_Kind // mode -> problems
+	}{
+		{
+			name:    "unchanged",
+			newCols: testColumns("a", "int", "b", "string"),
+			want:    map[string][]pb.SchemaIncompatibility_Kind{},
+		},
+		{
+			name:    "added at the end",
+			newCols: testColumns("a", "int", "b", "string", "c", "double"),
+			want:    map[string][]pb.SchemaIncompatibility_Kind{},
+		},
+		{
+			name:    "added in the middle",
+			newCols: testColumns("a", "int", "c", "double", "b", "string"),
+			want: map[string][]pb.SchemaIncompatibility_Kind{
+				compatBackward: {moved},
+				compatForward:  {moved},
+				compatFull:     {moved},
+			},
+		},
+		{
+			name:    "dropped",
+			newCols: testColumns("a", "int"),
+			want: map[string][]pb.SchemaIncompatibility_Kind{
+				compatBackward: {dropped},
+				compatForward:  {dropped},
+				compatFull:     {dropped},
+			},
+		},
+		{
+			name:    "reordered",
+			newCols: testColumns("b", "string", "a", "int"),
+			want: map[string][]pb.SchemaIncompatibility_Kind{
+				compatBackward: {moved, moved},
+				compatForward:  {moved, moved},
+				compatFull:     {moved, moved},
+			},
+		},
+		{
+			name:    "widened",
+			newCols: testColumns("A", "bigint", "b", "string"),
+			want: map[string][]pb.SchemaIncompatibility_Kind{
+				compatForward: {typ},
+				compatFull:    {typ},
+			},
+		},
+		{
+			name:    "narrowed",
+			newCols: testColumns("a", "smallint", "b", "string"),
+			want: map[string][]pb.SchemaIncompatibility_Kind{
+				compatBackward: {typ},
+				compatFull:     {typ},
+			},
+		},
+		{
+			name:    "changed",
+			newCols: testColumns("a", "int", "b", "binary"),
+			want: map[string][]pb.SchemaIncompatibility_Kind{
+				compatBackward: {typ},
+				compatForward:  {typ},
+				compatFull:     {typ},
+			},
+		},
+		{
+			name:    "comment changed",
+			newCols: []*pb.FieldSchema{{Name: "a", Type: "int", Comment: "id"}, {Name: "b", Type: "string"}},
+			want:    map[string][]pb.SchemaIncompatibility_Kind{},
+		},
+	}
+	for _, tt := range tests {
+		for _, mode := range []string{compatNone, compatBackward, compatForward, compatFull} {
+			problems := checkCompatibility(mode, oldCols, tt.newCols)
+			want := tt.want[mode]
+			if len(problems) != len(want) {
+				t.Errorf("%s in %s mode: got %v, want %v", tt.name, mode, problems, want)
+				continue
+			}
+			for i, problem := range problems {
+				if problem.Kind != want[i] {
+					t.Errorf("%s in %s mode: got %v, want %v", tt.name, mode, problem.Kind, want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestValidateAlteredTable(t *testing.T) {
+	// Table created before types were checked
+	old := &pb.Table{
+		Sd:            &pb.StorageDescriptor{Cols: testColumns("a", "legacy type", "b", "INT")},
+		PartitionKeys: testColumns("ds", "legacy key type"),
+		Parameters:    map[string]string{compatibilityParam: "legacy"},
+	}
+	tests := []struct {
+		name     string
+		cols     []*pb.FieldSchema
+		params   map[string]string
+		wantErr  bool
+		wantCols []*pb.FieldSchema
+	}{
+		{
+			name:     "unchanged",
+			cols:     testColumns("a", "legacy type", "b", "INT"),
+			params:   old.Parameters,
+			wantCols: testColumns("a", "legacy type", "b", "INT"),
+		},
+		{
+			name:     "added column",
+			cols:     testColumns("a", "legacy type", "b", "INT", "c", "MAP<STRING,INT>"),
+			params:   old.Parameters,
+			wantCols: testColumns("a", "legacy type", "b", "INT", "c", "map<string,int>"),
+		},
+		{
+			name:    "changed to invalid type",
+			cols:    testColumns("a", "legacy type", "b", "integr"),
+			params:  old.Parameters,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate of partition key",
+			cols:    testColumns("a", "legacy type", "b", "INT", "DS", "string"),
+			params:  old.Parameters,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate of unchanged column",
+			cols:    testColumns("a", "legacy type", "b", "INT", "A", "string"),
+			params:  old.Parameters,
+			wantErr: true,
+		},
+		{
+			name:     "valid mode",
+			cols:     testColumns("a", "legacy type", "b", "INT"),
+			params:   map[string]string{compatibilityParam: "full"},
+			wantCols: testColumns("a", "legacy type", "b", "INT"),
+		},
+		{
+			name:    "invalid mode",
+			cols:    testColumns("a", "legacy type", "b", "INT"),
+			params:  map[string]string{compatibilityParam: "other"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		table := &pb.Table{
+			Sd:            &pb.StorageDescriptor{Cols: tt.cols},
+			PartitionKeys: old.PartitionKeys,
+			Parameters:    tt.params,
+		}
+		err := validateAlteredTable(old, table)
+		if tt.wantErr {
+			if _, ok := err.(invalidError); !ok {
+				t.Errorf("%s: expected invalidError, got %v", tt.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if !equalColumns(table.Sd.Cols, tt.wantCols) {
+			t.Errorf("%s: columns %v, want %v", tt.name, table.Sd.Cols, tt.wantCols)
+		}
+	}
+}
+
+func TestSetTableFields(t *testing.T) {
+	tests := []struct {
+		table *pb.Table
+		want  []string
+	}{
+		{&pb.Table{}, nil},
+		{&pb.Table{Sd: &pb.StorageDescriptor{}}, []string{"sd"}},
+		{&pb.Table{TableType: pb.TableType_TTYPE_EXTERNAL, Parameters: map[string]string{"k": "v"}},
+			[]string{"table_type", "parameters"}},
+		{&pb.Table{Location: "/l", LastAccessTime: 1}, []string{"location", "last_access_time"}},
+	}
+	for _, tt := range tests {
+		if got := setTableFields(tt.table); !equalValues(got, tt.want) {
+			t.Errorf("setTableFields(%v) = %q, want %q", tt.table, got, tt.want)
+		}
+	}
+}
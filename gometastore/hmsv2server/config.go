@@ -0,0 +1,114 @@
+// Configuration
+//
+// Every command line flag can also be set in a config file specified by -config or
+// with an environment variable. Command line flags override environment variables
+// which override the config file.
+//
+// Config file keys are flag names. Files with .toml extension are parsed as TOML,
+// all others as YAML, e.g.
+//
+//   address: 0.0.0.0
+//   bolt-nosync: true
+//   keepalive-time: 2m
+//
+// Environment variables are flag names in upper case with HMS_ prefix and dashes
+// replaced by underscores, e.g. HMS_BOLT_NOSYNC=true.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	envPrefix  = "HMS_"
+	configFlag = "config"
+)
+
+// envName returns name of environment variable for the flag
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.Replace(flagName, "-", "_", -1))
+}
+
+// loadConfig sets flags which are not specified on the command line from
+// environment variables and the config file.
+//
+//   fs - parsed flag set
+//   configFile - YAML or TOML config file, may be empty
+func loadConfig(fs *flag.FlagSet, configFile string) error {
+	values := make(map[string]interface{})
+	if configFile != "" {
+		var err error
+		if values, err = readConfigFile(configFile); err != nil {
+			return err
+		}
+		for name := range values {
+			if fs.Lookup(name) == nil || name == configFlag {
+				return fmt.Errorf("%s: unknown option %s", configFile, name)
+			}
+		}
+	}
+
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || explicit[f.Name] || f.Name == configFlag {
+			return
+		}
+		if value, ok := os.LookupEnv(envName(f.Name)); ok {
+			if e := fs.Set(f.Name, value); e != nil {
+				err = fmt.Errorf("invalid %s: %v", envName(f.Name), e)
+			}
+			return
+		}
+		if value, ok := values[f.Name]; ok {
+			if e := fs.Set(f.Name, configValue(value)); e != nil {
+				err = fmt.Errorf("%s: invalid %s: %v", configFile, f.Name, e)
+			}
+		}
+	})
+	return err
+}
+
+// readConfigFile reads flat map of options from YAML or TOML file.
+func readConfigFile(path string) (map[string]interface{}, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{})
+	if filepath.Ext(path) == ".toml" {
+		err = toml.Unmarshal(data, &values)
+	} else {
+		err = yaml.Unmarshal(data, &values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return values, nil
+}
+
+// configValue converts config file value to the flag representation.
+// Lists are converted to comma-separated strings.
+func configValue(value interface{}) string {
+	if list, ok := value.([]interface{}); ok {
+		parts := make([]string, 0, len(list))
+		for _, v := range list {
+			parts = append(parts, fmt.Sprint(v))
+		}
+		return strings.Join(parts, ",")
+	}
+	return fmt.Sprint(value)
+}
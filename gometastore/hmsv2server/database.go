@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/boltdb/bolt"
 	"github.com/golang/protobuf/proto"
@@ -14,13 +13,14 @@ import (
 
 func (s *metastoreServer) CreateDabatase(c context.Context,
 	req *pb.CreateDatabaseRequest) (*pb.GetDatabaseResponse, error) {
-	log.Println("CreateDabatase:", req)
+	logger := requestLogger(c)
+	logger.Debug("CreateDabatase: ", req)
 	if req.Database == nil || req.Database.Id == nil {
 		return nil, fmt.Errorf("missing Database info")
 	}
 	catalog := req.Catalog
-	if catalog == "" {
-		return nil, fmt.Errorf("missing catalog")
+	if err := validCatalog(catalog); err != nil {
+		return nil, err
 	}
 	dbName := req.Database.Id.Name
 	if dbName == "" {
@@ -30,9 +30,13 @@ func (s *metastoreServer) CreateDabatase(c context.Context,
 	// Create unique ID if it isn's specified
 	database.Id.Id = getULID()
 	id := database.Id.Id
+	now := nowMillis()
+	principal := principalFromContext(c)
+	database.CreateTime, database.LastModifiedTime, database.LastAccessTime = now, now, now
+	database.CreatedBy, database.ModifiedBy = principal, principal
 
-	err := s.db.Update(func(tx *bolt.Tx) error {
-		catBucket, err := tx.CreateBucketIfNotExists([]byte(catalog))
+	err := s.update(c, func(tx *bolt.Tx) error {
+		catBucket, err := getCatalogBucket(tx, catalog)
 		if err != nil {
 			return err
 		}
@@ -84,7 +88,7 @@ func (s *metastoreServer) CreateDabatase(c context.Context,
 		// Store database info in idMap
 		data, err := proto.Marshal(database)
 		if err != nil {
-			log.Println("failed to deserialize", err)
+			logger.WithError(err).Error("failed to serialize database")
 			return err
 		}
 
@@ -92,14 +96,16 @@ func (s *metastoreServer) CreateDabatase(c context.Context,
 		if err != nil {
 			return err
 		}
-		return nil
+		idx, err := getIDIndex(catBucket)
+		if err != nil {
+			return err
+		}
+		return putIDLocation(idx, id, &idLocation{Kind: kindDatabase})
 	})
 
 	if err != nil {
-		log.Println("failed to create database:", err)
-		return &pb.GetDatabaseResponse{
-			Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_ERROR, Error: err.Error()},
-		}, nil
+		logger.WithError(err).Warn("failed to create database")
+		return &pb.GetDatabaseResponse{Status: errorStatus(err)}, nil
 	}
 
 	return &pb.GetDatabaseResponse{
@@ -110,7 +116,8 @@ func (s *metastoreServer) CreateDabatase(c context.Context,
 
 func (s *metastoreServer) GetDatabase(c context.Context,
 	req *pb.GetDatabaseRequest) (*pb.GetDatabaseResponse, error) {
-	log.Println("GetDatabase:", req)
+	logger := requestLogger(c)
+	logger.Debug("GetDatabase: ", req)
 	if req.Id == nil {
 		return nil, fmt.Errorf("missing identity info")
 	}
@@ -125,17 +132,7 @@ func (s *metastoreServer) GetDatabase(c context.Context,
 	}
 
 	var database pb.Database
-	if err := s.db.Update(func(tx *bolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists([]byte(catalog))
-		if err != nil {
-			return err
-		}
-		return nil
-	}); err != nil {
-		return nil, err
-	}
-
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.view(c, func(tx *bolt.Tx) error {
 		db, err := getDatabase(tx, catalog, req.Id)
 		if err != nil {
 			return err
@@ -145,10 +142,8 @@ func (s *metastoreServer) GetDatabase(c context.Context,
 	})
 
 	if err != nil {
-		log.Println("failed to get database:", err)
-		return &pb.GetDatabaseResponse{
-			Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_ERROR, Error: err.Error()},
-		}, nil
+		logger.WithError(err).Warn("failed to get database")
+		return &pb.GetDatabaseResponse{Status: errorStatus(err)}, nil
 	}
 
 	return &pb.GetDatabaseResponse{
@@ -159,28 +154,18 @@ func (s *metastoreServer) GetDatabase(c context.Context,
 
 func (s *metastoreServer) ListDatabases(req *pb.ListDatabasesRequest,
 	stream pb.Metastore_ListDatabasesServer) error {
-	log.Println("ListDatabases", req)
+	logger := requestLogger(stream.Context())
+	logger.Debug("ListDatabases: ", req)
 	catalog := req.Catalog
 	if catalog == "" {
 		return fmt.Errorf("empty catalog")
 	}
 
-	bucketName := []byte(catalog)
-	if err := s.db.Update(func(tx *bolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists(bucketName)
+	err := s.view(stream.Context(), func(tx *bolt.Tx) error {
+		catalogBucket, err := getCatalogBucket(tx, catalog)
 		if err != nil {
 			return err
 		}
-		return nil
-	}); err != nil {
-		return err
-	}
-
-	err := s.db.View(func(tx *bolt.Tx) error {
-		catalogBucket := tx.Bucket(bucketName)
-		if catalogBucket == nil {
-			return fmt.Errorf("bucket %s doesn't exist", bucketName)
-		}
 		idMap := catalogBucket.Bucket([]byte(byIDHdr))
 		if idMap == nil {
 			return nil
@@ -192,6 +177,9 @@ func (s *metastoreServer) ListDatabases(req *pb.ListDatabasesRequest,
 			if err != nil {
 				return nil
 			}
+			if database.LastModifiedTime < req.ModifiedSince {
+				return nil
+			}
 			if len(req.GetFields()) != 0 {
 				// Only include specified fields
 				db := &pb.Database{}
@@ -212,15 +200,13 @@ func (s *metastoreServer) ListDatabases(req *pb.ListDatabasesRequest,
 						db.SystemParameters = database.SystemParameters
 					}
 				}
-				log.Println("send", db)
 				if err = stream.Send(db); err != nil {
-					log.Println("err sending ", err)
+					logger.WithError(err).Warn("failed to send database")
 					return err
 				}
 			} else {
-				log.Println("send", database)
 				if err = stream.Send(database); err != nil {
-					log.Println("err sending ", err)
+					logger.WithError(err).Warn("failed to send database")
 					return err
 				}
 			}
@@ -230,8 +216,8 @@ func (s *metastoreServer) ListDatabases(req *pb.ListDatabasesRequest,
 	})
 
 	if err != nil {
-		log.Println("failed to list databases:", err)
-		return err
+		logger.WithError(err).Warn("failed to list databases")
+		return streamError(err)
 	}
 
 	return nil
@@ -239,7 +225,8 @@ func (s *metastoreServer) ListDatabases(req *pb.ListDatabasesRequest,
 
 func (s *metastoreServer) DropDatabase(c context.Context,
 	req *pb.DropDatabaseRequest) (*pb.RequestStatus, error) {
-	log.Println("DropDatabase:", req)
+	logger := requestLogger(c)
+	logger.Debug("DropDatabase: ", req)
 	if req.Id == nil {
 		return nil, fmt.Errorf("missing identity info")
 	}
@@ -252,28 +239,55 @@ func (s *metastoreServer) DropDatabase(c context.Context,
 		return nil, fmt.Errorf("missing database name")
 	}
 
-	err := s.db.Update(func(tx *bolt.Tx) error {
+	err := s.update(c, func(tx *bolt.Tx) error {
 		nameMap, idMap, idBytes, err := getDatabaseID(tx, catalog, req.Id)
 		if err != nil {
 			return err
 		}
-		catalogBucket := tx.Bucket([]byte(catalog))
-		if catalogBucket == nil {
-			return fmt.Errorf("bucket %s doesn't exist", catalog)
+		catalogBucket, err := getCatalogBucket(tx, catalog)
+		if err != nil {
+			return err
+		}
+
+		if !req.Purge && s.trashRetention > 0 {
+			info := s.newDroppedObject(c, pb.DroppedObject_DROPPED_DATABASE,
+				&pb.Id{Name: dbName, Id: string(idBytes)}, nil)
+			var dbBucket *bolt.Bucket
+			if dbInfo := catalogBucket.Bucket([]byte(dbHdr)); dbInfo != nil {
+				dbBucket = dbInfo.Bucket(idBytes)
+			}
+			if err := trashDatabase(catalogBucket, info, idMap.Get(idBytes), dbBucket); err != nil {
+				return err
+			}
 		}
 
 		// Remove info from this DB
-		nameMap.Delete([]byte(dbName))
-		idMap.Delete(idBytes)
+		if err := nameMap.Delete([]byte(dbName)); err != nil {
+			return err
+		}
+		if err := idMap.Delete(idBytes); err != nil {
+			return err
+		}
+		idx, err := getIDIndex(catalogBucket)
+		if err != nil {
+			return err
+		}
 		if dbInfo := catalogBucket.Bucket([]byte(dbHdr)); dbInfo != nil {
-			dbInfo.DeleteBucket(idBytes)
+			if err := unindexDatabase(idx, idBytes, dbInfo.Bucket(idBytes)); err != nil {
+				return err
+			}
+			if err := dbInfo.DeleteBucket(idBytes); err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+		} else if err := idx.Delete(idBytes); err != nil {
+			return err
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		log.Println("failed to delete database:", err)
+		logger.WithError(err).Warn("failed to delete database")
 		return nil, err
 	}
 
@@ -282,7 +296,8 @@ func (s *metastoreServer) DropDatabase(c context.Context,
 
 func (s metastoreServer) AlterDatabase(c context.Context,
 	req *pb.AlterDatabaseRequest) (*pb.GetDatabaseResponse, error) {
-	log.Println("AlterDatabase:", req)
+	logger := requestLogger(c)
+	logger.Debug("AlterDatabase: ", req)
 	if req.Database == nil {
 		return nil, fmt.Errorf("missing database")
 	}
@@ -299,7 +314,7 @@ func (s metastoreServer) AlterDatabase(c context.Context,
 		return nil, fmt.Errorf("missing database name")
 	}
 	var database pb.Database
-	err := s.db.Update(func(tx *bolt.Tx) error {
+	err := s.update(c, func(tx *bolt.Tx) error {
 		_, idMap, idBytes, err := getDatabaseID(tx, catalog, req.Id)
 		if err != nil {
 			return err
@@ -322,14 +337,21 @@ func (s metastoreServer) AlterDatabase(c context.Context,
 		if db.Location != "" {
 			database.Location = db.Location
 		}
-		return nil
+		if db.LastAccessTime != 0 {
+			database.LastAccessTime = db.LastAccessTime
+		}
+		database.LastModifiedTime = nowMillis()
+		database.ModifiedBy = principalFromContext(c)
+		data, err = proto.Marshal(&database)
+		if err != nil {
+			return err
+		}
+		return idMap.Put(idBytes, data)
 	})
 
 	if err != nil {
-		log.Println("failed to alter database:", err)
-		return &pb.GetDatabaseResponse{
-			Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_ERROR, Error: err.Error()},
-		}, nil
+		logger.WithError(err).Warn("failed to alter database")
+		return &pb.GetDatabaseResponse{Status: errorStatus(err)}, nil
 	}
 
 	return &pb.GetDatabaseResponse{
@@ -340,9 +362,9 @@ func (s metastoreServer) AlterDatabase(c context.Context,
 
 func getDatabaseID(tx *bolt.Tx, catalog string, id *pb.Id) (*bolt.Bucket, *bolt.Bucket,
 	[]byte, error) {
-	catalogBucket := tx.Bucket([]byte(catalog))
-	if catalogBucket == nil {
-		return nil, nil, nil, fmt.Errorf("bucket %s doesn't exist", catalog)
+	catalogBucket, err := getCatalogBucket(tx, catalog)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 	idBucket := catalogBucket.Bucket([]byte(byIDHdr))
 	if idBucket == nil {
@@ -357,7 +379,7 @@ func getDatabaseID(tx *bolt.Tx, catalog string, id *pb.Id) (*bolt.Bucket, *bolt.
 		// Locate ID by name
 		idBytes = nameIDBucket.Get([]byte(id.Name))
 		if idBytes == nil {
-			return nil, nil, nil, fmt.Errorf("database %s doesn't exist", id.Name)
+			return nil, nil, nil, notFoundError(fmt.Sprintf("database %s doesn't exist", id.Name))
 		}
 	}
 	return nameIDBucket, idBucket, idBytes, nil
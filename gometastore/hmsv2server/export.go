@@ -0,0 +1,695 @@
+// Catalog export and import
+//
+// Catalogs are exported as JSON Lines: every line describes a single catalog, database,
+// table, table schema version or partition and its parent names. Objects are encoded as protobuf JSON
+// with their IDs and sequence IDs, e.g.
+//
+//   {"kind":"catalog","catalog":"hive","object":{"name":"hive","description":"..."}}
+//   {"kind":"database","catalog":"hive","object":{"id":{"name":"db1","id":"..."},"seq_id":"1"}}
+//   {"kind":"table","catalog":"hive","database":"db1","object":{...}}
+//   {"kind":"schema","catalog":"hive","database":"db1","table":"t1","object":{"version":1,...}}
+//   {"kind":"partition","catalog":"hive","database":"db1","table":"t1","object":{...}}
+//
+// Parents always precede their children, so the file can be imported sequentially.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+)
+
+// Kinds of exported objects
+const (
+	kindCatalog   = "catalog"
+	kindDatabase  = "database"
+	kindTable     = "table"
+	kindSchema    = "schema"
+	kindPartition = "partition"
+)
+
+// Conflict resolution modes for import
+const (
+	conflictFail      = "fail"
+	conflictSkip      = "skip"
+	conflictOverwrite = "overwrite"
+)
+
+// exportRecord is a single line of export file
+type exportRecord struct {
+	Kind     string          `json:"kind"`
+	Catalog  string          `json:"catalog"`
+	Database string          `json:"database,omitempty"`
+	Table    string          `json:"table,omitempty"`
+	Object   json.RawMessage `json:"object"`
+}
+
+var jsonMarshaler = jsonpb.Marshaler{OrigName: true}
+
+// exporter writes catalog objects as JSON lines
+type exporter struct {
+	w     *bufio.Writer
+	count map[string]int
+}
+
+func (e *exporter) write(record *exportRecord, msg proto.Message) error {
+	var buf bytes.Buffer
+	if err := jsonMarshaler.Marshal(&buf, msg); err != nil {
+		return err
+	}
+	record.Object = buf.Bytes()
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err = e.w.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	e.count[record.Kind]++
+	return nil
+}
+
+// exportCatalog writes all databases, tables and partitions of the catalog.
+func (e *exporter) exportCatalog(tx *bolt.Tx, catalog string) error {
+	catBucket, err := getCatalogBucket(tx, catalog)
+	if err != nil {
+		return err
+	}
+	cat, err := decodeCatalog(catalog, catBucket)
+	if err != nil {
+		return err
+	}
+	if err = e.write(&exportRecord{Kind: kindCatalog, Catalog: catalog}, cat); err != nil {
+		return err
+	}
+	idMap := catBucket.Bucket([]byte(byIDHdr))
+	if idMap == nil {
+		// Empty catalog
+		return nil
+	}
+	return idMap.ForEach(func(k, v []byte) error {
+		database := new(pb.Database)
+		if err := proto.Unmarshal(v, database); err != nil {
+			return fmt.Errorf("can't decode database %s: %v", k, err)
+		}
+		if err := e.write(&exportRecord{Kind: kindDatabase, Catalog: catalog}, database); err != nil {
+			return err
+		}
+		dbBucket, err := getDatabaseBucket(tx, catalog, &pb.Id{Id: string(k)})
+		if err != nil {
+			return err
+		}
+		return e.exportTables(dbBucket, catalog, database.Id.Name)
+	})
+}
+
+// exportTables writes all tables of the database with their partitions.
+func (e *exporter) exportTables(dbBucket *bolt.Bucket, catalog string, dbName string) error {
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	if byIDBucket == nil {
+		return fmt.Errorf("corrupt catalog %s/%s: no BYID info", catalog, dbName)
+	}
+	tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
+	if tablesBucket == nil {
+		return fmt.Errorf("corrupt catalog %s/%s: no TBLS info", catalog, dbName)
+	}
+	return byIDBucket.ForEach(func(k, v []byte) error {
+		table := new(pb.Table)
+		if err := proto.Unmarshal(v, table); err != nil {
+			return fmt.Errorf("can't decode table %s/%s: %v", dbName, k, err)
+		}
+		if err := e.write(&exportRecord{Kind: kindTable, Catalog: catalog,
+			Database: dbName}, table); err != nil {
+			return err
+		}
+		if err := e.exportSchemas(dbBucket, k, catalog, dbName, table.Id.Name); err != nil {
+			return err
+		}
+		partBucket := tablesBucket.Bucket(k)
+		if partBucket == nil {
+			return nil
+		}
+		return partBucket.ForEach(func(pk, pv []byte) error {
+			partition := new(pb.Partition)
+			if err := proto.Unmarshal(pv, partition); err != nil {
+				return fmt.Errorf("can't decode partition %s.%s/%x: %v",
+					dbName, table.Id.Name, pk, err)
+			}
+			expandSd(table.Sd, partition)
+			return e.write(&exportRecord{Kind: kindPartition, Catalog: catalog,
+				Database: dbName, Table: table.Id.Name}, partition)
+		})
+	})
+}
+
+// exportSchemas writes schema history of the table.
+func (e *exporter) exportSchemas(dbBucket *bolt.Bucket, tableID []byte, catalog string,
+	dbName string, tableName string) error {
+	history := getSchemaBucket(dbBucket, tableID)
+	if history == nil {
+		return nil
+	}
+	return history.ForEach(func(k, v []byte) error {
+		schema := new(pb.TableSchema)
+		if err := proto.Unmarshal(v, schema); err != nil {
+			return fmt.Errorf("can't decode schema of table %s.%s: %v", dbName, tableName, err)
+		}
+		return e.write(&exportRecord{Kind: kindSchema, Catalog: catalog,
+			Database: dbName, Table: tableName}, schema)
+	})
+}
+
+// exportCommand exports one or all catalogs.
+func exportCommand(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	catalog := fs.String("catalog", "", "Catalog to export, all catalogs if empty")
+	output := fs.String("o", "", "Output file, stdout if empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	db, err := openMetastore(true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	out := os.Stdout
+	if *output != "" {
+		if out, err = os.Create(*output); err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	e := &exporter{w: bufio.NewWriter(out), count: make(map[string]int)}
+	err = db.View(func(tx *bolt.Tx) error {
+		if *catalog != "" {
+			return e.exportCatalog(tx, *catalog)
+		}
+		return forEachCatalog(tx, func(name []byte, _ *bolt.Bucket) error {
+			return e.exportCatalog(tx, string(name))
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if err = e.w.Flush(); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"catalogs":   e.count[kindCatalog],
+		"databases":  e.count[kindDatabase],
+		"tables":     e.count[kindTable],
+		"schemas":    e.count[kindSchema],
+		"partitions": e.count[kindPartition],
+	}).Info("export completed")
+	return nil
+}
+
+// importPath identifies a database or a table in the target catalog
+type importPath struct {
+	catalog, database, table string
+}
+
+// importer loads exported objects
+type importer struct {
+	catalog     string // Target catalog, catalog from the file is used if empty
+	preserveIDs bool   // Keep IDs and sequence IDs from the file
+	onConflict  string // How to handle objects which already exist
+	count       map[string]int
+	// Existing databases and tables skipped on conflict. Their children in the
+	// file are skipped as well instead of being merged into the existing objects.
+	skipped map[importPath]bool
+	// Overwritten tables. They keep their schema history, the overwrite adds a
+	// version if it changes the columns.
+	altered map[importPath]bool
+}
+
+// assignIDs sets object ID and sequence ID. When IDs are preserved, the bucket
+// sequence is advanced past the imported sequence ID, so that objects created
+// later don't reuse it.
+//
+//   id - object ID, must be non-nil
+//   seqID - object sequence ID
+//   seqBucket - bucket which sequence is used for sequence IDs
+//   idx - catalog ID index
+func (i *importer) assignIDs(id *pb.Id, seqID *uint64, seqBucket *bolt.Bucket,
+	idx *bolt.Bucket) error {
+	if !i.preserveIDs || id.Id == "" {
+		id.Id = getULID()
+	} else if idx.Get([]byte(id.Id)) != nil {
+		return fmt.Errorf("ID %s of %s is already used", id.Id, id.Name)
+	}
+	if !i.preserveIDs || *seqID == 0 {
+		*seqID, _ = seqBucket.NextSequence()
+		return nil
+	}
+	if *seqID > seqBucket.Sequence() {
+		return seqBucket.SetSequence(*seqID)
+	}
+	return nil
+}
+
+// conflict handles an object which already exists.
+// It returns true if the existing object should be overwritten.
+func (i *importer) conflict(kind string, name string) (bool, error) {
+	switch i.onConflict {
+	case conflictSkip:
+		i.count["skipped"]++
+		return false, nil
+	case conflictOverwrite:
+		return true, nil
+	default:
+		return false, fmt.Errorf("%s %s already exists", kind, name)
+	}
+}
+
+func (i *importer) importCatalog(tx *bolt.Tx, catalog string, cat *pb.Catalog) error {
+	// Catalog may be renamed by -catalog
+	cat.Name = catalog
+	catBucket := tx.Bucket([]byte(catalog))
+	if catBucket == nil {
+		i.count[kindCatalog]++
+		_, err := createCatalog(tx, cat)
+		return err
+	}
+	overwrite, err := i.conflict(kindCatalog, catalog)
+	if !overwrite {
+		return err
+	}
+	i.count["overwritten"]++
+	return putCatalog(catBucket, cat)
+}
+
+// catalogBucket returns bucket of the catalog, creating the catalog if needed.
+// Files exported before catalog records were added have no catalog lines.
+func (i *importer) catalogBucket(tx *bolt.Tx, catalog string) (*bolt.Bucket, error) {
+	if catBucket := tx.Bucket([]byte(catalog)); catBucket != nil {
+		return catBucket, nil
+	}
+	i.count[kindCatalog]++
+	return createCatalog(tx, &pb.Catalog{Name: catalog})
+}
+
+func (i *importer) importDatabase(tx *bolt.Tx, catalog string, database *pb.Database) error {
+	if database.Id == nil || database.Id.Name == "" {
+		return fmt.Errorf("missing database name")
+	}
+	dbName := database.Id.Name
+	catBucket, err := i.catalogBucket(tx, catalog)
+	if err != nil {
+		return err
+	}
+	nameMap, err := catBucket.CreateBucketIfNotExists([]byte(bynameHdr))
+	if err != nil {
+		return err
+	}
+	idMap, err := catBucket.CreateBucketIfNotExists([]byte(byIDHdr))
+	if err != nil {
+		return err
+	}
+	if existingID := nameMap.Get([]byte(dbName)); existingID != nil {
+		overwrite, err := i.conflict(kindDatabase, dbName)
+		if !overwrite {
+			if err == nil {
+				i.skipped[importPath{catalog, dbName, ""}] = true
+			}
+			return err
+		}
+		// Keep identity of the existing database, tables refer to it
+		var existing pb.Database
+		if err = proto.Unmarshal(idMap.Get(existingID), &existing); err != nil {
+			return fmt.Errorf("can't decode database %s: %v", dbName, err)
+		}
+		database.Id.Id = string(existingID)
+		database.SeqId = existing.SeqId
+		data, err := proto.Marshal(database)
+		if err != nil {
+			return err
+		}
+		i.count["overwritten"]++
+		return idMap.Put(existingID, data)
+	}
+
+	idx, err := getIDIndex(catBucket)
+	if err != nil {
+		return err
+	}
+	if err = i.assignIDs(database.Id, &database.SeqId, catBucket, idx); err != nil {
+		return err
+	}
+	id := []byte(database.Id.Id)
+	dbBucket, err := catBucket.CreateBucketIfNotExists([]byte(dbHdr))
+	if err != nil {
+		return err
+	}
+	dbDataBucket, err := dbBucket.CreateBucket(id)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{bynameHdr, byIDHdr, tblsHdr} {
+		if _, err = dbDataBucket.CreateBucket([]byte(name)); err != nil {
+			return err
+		}
+	}
+	if err = nameMap.Put([]byte(dbName), id); err != nil {
+		return err
+	}
+	data, err := proto.Marshal(database)
+	if err != nil {
+		return err
+	}
+	if err = idMap.Put(id, data); err != nil {
+		return err
+	}
+	i.count[kindDatabase]++
+	return putIDLocation(idx, database.Id.Id, &idLocation{Kind: kindDatabase})
+}
+
+func (i *importer) importTable(tx *bolt.Tx, catalog string, dbName string, table *pb.Table) error {
+	if table.Id == nil || table.Id.Name == "" {
+		return fmt.Errorf("missing table name")
+	}
+	tableName := table.Id.Name
+	if table.SchemaVersion == 0 {
+		// Exported before schema versioning
+		table.SchemaVersion = 1
+	}
+	dbBucket, err := getDatabaseBucket(tx, catalog, &pb.Id{Name: dbName})
+	if err != nil {
+		return err
+	}
+	byNameBucket := dbBucket.Bucket([]byte(bynameHdr))
+	if byNameBucket == nil {
+		return fmt.Errorf("corrupt catalog %s/%s: no BYNAME info", catalog, dbName)
+	}
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	if byIDBucket == nil {
+		return fmt.Errorf("corrupt catalog %s/%s: no BYID info", catalog, dbName)
+	}
+	tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
+	if tablesBucket == nil {
+		return fmt.Errorf("corrupt catalog %s/%s: no TBLS info", catalog, dbName)
+	}
+	if existingID := byNameBucket.Get([]byte(tableName)); existingID != nil {
+		overwrite, err := i.conflict(kindTable, dbName+"."+tableName)
+		if !overwrite {
+			if err == nil {
+				i.skipped[importPath{catalog, dbName, tableName}] = true
+			}
+			return err
+		}
+		// Keep identity of the existing table, partitions refer to it
+		var existing pb.Table
+		if err = proto.Unmarshal(byIDBucket.Get(existingID), &existing); err != nil {
+			return fmt.Errorf("can't decode table %s.%s: %v", dbName, tableName, err)
+		}
+		// Stored partitions are keyed by the existing partition keys
+		if !samePartitionKeys(existing.PartitionKeys, table.PartitionKeys) {
+			return invalidError(fmt.Sprintf("partition keys of table %s.%s can't be changed",
+				dbName, tableName))
+		}
+		table.Id.Id = string(existingID)
+		table.SeqId = existing.SeqId
+		table.PartitionKeys = existing.PartitionKeys
+		// The overwrite is an alter, which assigns the schema version
+		table.SchemaVersion = existing.SchemaVersion
+		i.count["overwritten"]++
+		i.altered[importPath{catalog, dbName, tableName}] = true
+		return alterTable(dbBucket, existingID, &existing, table, false)
+	}
+
+	idx, err := getCatalogIDIndex(tx, catalog)
+	if err != nil {
+		return err
+	}
+	if err = i.assignIDs(table.Id, &table.SeqId, dbBucket, idx); err != nil {
+		return err
+	}
+	id := []byte(table.Id.Id)
+	if _, err = tablesBucket.CreateBucket(id); err != nil {
+		return err
+	}
+	if err = byNameBucket.Put([]byte(tableName), id); err != nil {
+		return err
+	}
+	data, err := proto.Marshal(table)
+	if err != nil {
+		return err
+	}
+	if err = byIDBucket.Put(id, data); err != nil {
+		return err
+	}
+	// Schema records of the table follow and replace this one
+	if err = putTableSchema(dbBucket, id, tableSchema(table)); err != nil {
+		return err
+	}
+	_, _, dbID, err := getDatabaseID(tx, catalog, &pb.Id{Name: dbName})
+	if err != nil {
+		return err
+	}
+	i.count[kindTable]++
+	return putIDLocation(idx, table.Id.Id, &idLocation{Kind: kindTable, DbID: string(dbID)})
+}
+
+func (i *importer) importSchema(tx *bolt.Tx, catalog string, dbName string, tableName string,
+	schema *pb.TableSchema) error {
+	if schema.Version <= 0 {
+		return fmt.Errorf("invalid schema version %d", schema.Version)
+	}
+	dbBucket, err := getDatabaseBucket(tx, catalog, &pb.Id{Name: dbName})
+	if err != nil {
+		return err
+	}
+	_, tableID, err := getTable(dbBucket, catalog, dbName, tableName)
+	if err != nil {
+		return err
+	}
+	// The current version is recorded by importTable, other columns mean conflict
+	if existing, err := getTableSchema(dbBucket, tableID, schema.Version); err == nil &&
+		!proto.Equal(&pb.TableSchema{Cols: existing.Cols}, &pb.TableSchema{Cols: schema.Cols}) {
+		overwrite, err := i.conflict(kindSchema,
+			fmt.Sprintf("%s.%s version %d", dbName, tableName, schema.Version))
+		if !overwrite {
+			return err
+		}
+		i.count["overwritten"]++
+	} else {
+		i.count[kindSchema]++
+	}
+	return putTableSchema(dbBucket, tableID, schema)
+}
+
+func (i *importer) importPartition(tx *bolt.Tx, catalog string, dbName string, tableName string,
+	partition *pb.Partition) error {
+	if len(partition.GetValues()) == 0 {
+		return fmt.Errorf("missing partition values")
+	}
+	if partition.Id == nil {
+		partition.Id = &pb.Id{}
+	}
+	partition.Table = nil
+	dbBucket, err := getDatabaseBucket(tx, catalog, &pb.Id{Name: dbName})
+	if err != nil {
+		return err
+	}
+	table, tableID, err := getTable(dbBucket, catalog, dbName, tableName)
+	if err != nil {
+		return err
+	}
+	if partition.Values, err = normalizePartValues(table, partition.Values); err != nil {
+		return err
+	}
+	key, err := partitionKey(table, partition.Values)
+	if err != nil {
+		return err
+	}
+	name, _ := partitionName(table, partition.Values)
+	partBucket, err := getTableBucket(dbBucket, catalog, dbName, tableName, true)
+	if err != nil {
+		return err
+	}
+	idx, err := getCatalogIDIndex(tx, catalog)
+	if err != nil {
+		return err
+	}
+	if data := partBucket.Get(key); data != nil {
+		overwrite, err := i.conflict(kindPartition, dbName+"."+tableName+"/"+name)
+		if !overwrite {
+			return err
+		}
+		var existing pb.Partition
+		if err = proto.Unmarshal(data, &existing); err != nil {
+			return fmt.Errorf("can't decode partition %s.%s/%s: %v", dbName, tableName, name, err)
+		}
+		partition.Id.Id = existing.GetId().GetId()
+		partition.SeqId = existing.SeqId
+		i.count["overwritten"]++
+	} else {
+		if err = i.assignIDs(partition.Id, &partition.SeqId, partBucket, idx); err != nil {
+			return err
+		}
+		i.count[kindPartition]++
+	}
+	compactSd(table.Sd, partition)
+	data, err := proto.Marshal(partition)
+	if err != nil {
+		return err
+	}
+	if err = partBucket.Put(key, data); err != nil {
+		return err
+	}
+	return indexPartition(idx, string(tableID), partition)
+}
+
+// importRecord decodes and imports a single line
+func (i *importer) importRecord(tx *bolt.Tx, line []byte) error {
+	var record exportRecord
+	if err := json.Unmarshal(line, &record); err != nil {
+		return err
+	}
+	catalog := record.Catalog
+	if i.catalog != "" {
+		catalog = i.catalog
+	}
+	if err := validCatalog(catalog); err != nil {
+		return err
+	}
+	if i.skipped[importPath{catalog, record.Database, ""}] ||
+		i.skipped[importPath{catalog, record.Database, record.Table}] {
+		i.count["skipped"]++
+		return nil
+	}
+	if record.Kind == kindSchema && i.altered[importPath{catalog, record.Database, record.Table}] {
+		return nil
+	}
+	var msg proto.Message
+	switch record.Kind {
+	case kindCatalog:
+		msg = new(pb.Catalog)
+	case kindDatabase:
+		msg = new(pb.Database)
+	case kindTable:
+		msg = new(pb.Table)
+	case kindSchema:
+		msg = new(pb.TableSchema)
+	case kindPartition:
+		msg = new(pb.Partition)
+	default:
+		return fmt.Errorf("unknown kind %s", record.Kind)
+	}
+	if err := jsonpb.Unmarshal(bytes.NewReader(record.Object), msg); err != nil {
+		return err
+	}
+	switch m := msg.(type) {
+	case *pb.Catalog:
+		return i.importCatalog(tx, catalog, m)
+	case *pb.Database:
+		return i.importDatabase(tx, catalog, m)
+	case *pb.Table:
+		return i.importTable(tx, catalog, record.Database, m)
+	case *pb.TableSchema:
+		return i.importSchema(tx, catalog, record.Database, record.Table, m)
+	default:
+		return i.importPartition(tx, catalog, record.Database, record.Table, msg.(*pb.Partition))
+	}
+}
+
+// importCommand imports export file into the database.
+// The file is imported in a single transaction unless -batch is given. With -batch
+// every batch is committed separately, so a failure leaves the batches before it
+// imported.
+func importCommand(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	catalog := fs.String("catalog", "", "Import into this catalog instead of the one in the file")
+	preserveIDs := fs.Bool("preserve-ids", false, "Keep IDs and sequence IDs from the file")
+	onConflict := fs.String("on-conflict", conflictFail,
+		"What to do with existing objects: fail, skip or overwrite")
+	batchSize := fs.Int("batch", 0,
+		"Number of records committed in one transaction, the whole file if 0")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	switch *onConflict {
+	case conflictFail, conflictSkip, conflictOverwrite:
+	default:
+		return fmt.Errorf("invalid -on-conflict value %s", *onConflict)
+	}
+	if *batchSize < 0 {
+		return fmt.Errorf("invalid batch size %d", *batchSize)
+	}
+
+	var in io.Reader = os.Stdin
+	if fs.NArg() > 0 {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	db, err := openMetastore(false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	i := &importer{
+		catalog:     *catalog,
+		preserveIDs: *preserveIDs,
+		onConflict:  *onConflict,
+		count:       make(map[string]int),
+		skipped:     make(map[importPath]bool),
+		altered:     make(map[importPath]bool),
+	}
+	reader := bufio.NewReader(in)
+	lineNo := 0
+	for eof := false; !eof; {
+		err = db.Update(func(tx *bolt.Tx) error {
+			for n := 0; *batchSize == 0 || n < *batchSize; {
+				line, err := reader.ReadBytes('\n')
+				if err == io.EOF {
+					eof = true
+				} else if err != nil {
+					return err
+				}
+				lineNo++
+				if line = bytes.TrimSpace(line); len(line) != 0 {
+					if err := i.importRecord(tx, line); err != nil {
+						return fmt.Errorf("line %d: %v", lineNo, err)
+					}
+					n++
+				}
+				if eof {
+					break
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	log.WithFields(log.Fields{
+		"catalogs":    i.count[kindCatalog],
+		"databases":   i.count[kindDatabase],
+		"tables":      i.count[kindTable],
+		"schemas":     i.count[kindSchema],
+		"partitions":  i.count[kindPartition],
+		"skipped":     i.count["skipped"],
+		"overwritten": i.count["overwritten"],
+	}).Info("import completed")
+	return nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+)
+
+// importLines imports the lines in one transaction
+func importLines(s *metastoreServer, i *importer, lines ...string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, line := range lines {
+			if err := i.importRecord(tx, []byte(line)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func newTestImporter(onConflict string) *importer {
+	return &importer{
+		onConflict: onConflict,
+		count:      make(map[string]int),
+		skipped:    make(map[importPath]bool),
+		altered:    make(map[importPath]bool),
+	}
+}
+
+func TestImportSkip(t *testing.T) {
+	s, _ := newTestServer(t, 0)
+	i := newTestImporter(conflictSkip)
+	err := importLines(s, i,
+		`{"kind":"database","catalog":"cat","object":{"id":{"name":"db"}}}`,
+		`{"kind":"table","catalog":"cat","database":"db","object":{"id":{"name":"new"},`+
+			`"partitionKeys":[{"name":"hr","type":"int"}]}}`,
+		`{"kind":"partition","catalog":"cat","database":"db","table":"tbl","object":{"values":["2"]}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i.count["skipped"] != 3 {
+		t.Errorf("%d records skipped, want 3", i.count["skipped"])
+	}
+	resp, err := s.GetTable(context.Background(), &pb.GetTableRequest{
+		Catalog: testCatalog,
+		DbId:    &pb.Id{Name: testDb},
+		Id:      &pb.Id{Name: "new"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus().GetStatus() != pb.RequestStatus_STATUS_NOTFOUND {
+		t.Errorf("table of skipped database is imported")
+	}
+	names, err := s.GetPartitionNames(context.Background(), &pb.GetPartitionNamesRequest{
+		Catalog: testCatalog,
+		DbId:    &pb.Id{Name: testDb},
+		TableId: &pb.Id{Name: testTbl},
+	})
+	checkStatus(t, names.GetStatus(), err)
+	if len(names.Names) != 1 {
+		t.Errorf("partitions %q after import into skipped table, want [hr=1]", names.Names)
+	}
+}
+
+func TestImportOverwriteTable(t *testing.T) {
+	tests := []struct {
+		name        string
+		table       string
+		wantErr     bool
+		wantVersion int32
+	}{
+		{
+			name: "columns added",
+			table: `{"id":{"name":"tbl"},"schema_version":5,` +
+				`"sd":{"cols":[{"name":"a","type":"string"},{"name":"b","type":"INT"}]},` +
+				`"partitionKeys":[{"name":"hr","type":"int"}]}`,
+			wantVersion: 2,
+		},
+		{
+			name: "partition keys changed",
+			table: `{"id":{"name":"tbl"},"sd":{"cols":[{"name":"a","type":"string"}]},` +
+				`"partitionKeys":[{"name":"hr","type":"string"}]}`,
+			wantErr: true,
+		},
+		{
+			name: "invalid column type",
+			table: `{"id":{"name":"tbl"},"sd":{"cols":[{"name":"a","type":"integr"}]},` +
+				`"partitionKeys":[{"name":"hr","type":"int"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, table := newTestServer(t, 0)
+			err := importLines(s, newTestImporter(conflictOverwrite),
+				`{"kind":"table","catalog":"cat","database":"db","object":`+tt.table+`}`,
+				// Schema history of the file doesn't replace the existing one
+				`{"kind":"schema","catalog":"cat","database":"db","table":"tbl",`+
+					`"object":{"version":1,"cols":[{"name":"x","type":"string"}]}}`)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := s.GetTable(context.Background(), &pb.GetTableRequest{
+				Catalog: testCatalog,
+				DbId:    &pb.Id{Name: testDb},
+				Id:      &pb.Id{Name: testTbl},
+			})
+			checkStatus(t, resp.GetStatus(), err)
+			if resp.Table.Id.Id != table.Id.Id || resp.Table.SchemaVersion != tt.wantVersion {
+				t.Errorf("overwritten table has ID %s and version %d, want %s and %d",
+					resp.Table.Id.Id, resp.Table.SchemaVersion, table.Id.Id, tt.wantVersion)
+			}
+			s.db.View(func(tx *bolt.Tx) error {
+				dbBucket, err := getDatabaseBucket(tx, testCatalog, &pb.Id{Name: testDb})
+				if err != nil {
+					t.Fatal(err)
+				}
+				schema, err := getTableSchema(dbBucket, []byte(table.Id.Id), 1)
+				if err != nil || !equalColumns(schema.Cols, testColumns("a", "string")) {
+					t.Errorf("schema version 1 is %v (%v), want the original columns", schema, err)
+				}
+				return nil
+			})
+		})
+	}
+}
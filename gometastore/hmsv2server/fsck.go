@@ -0,0 +1,475 @@
+// Consistency checker
+//
+// The fsck command walks every catalog and verifies the layout described in server.go:
+//
+//   - catalogs have a catalog record with their name
+//   - catalogs have BYNAME, BYID and DB buckets, databases have BYNAME, BYID and TBLS
+//   - every BYID record can be decoded and its ID matches the key
+//   - BYNAME and BYID maps agree in both directions
+//   - every database has DB/<id> bucket and every table has TBLS/<id> bucket
+//   - there are no orphaned DB/<id> and TBLS/<id> buckets
+//   - partitions can be decoded and are stored under their keys
+//   - ID index has correct entries for all databases, tables and partitions
+//   - schema history has the current version of every table and no missing tables
+//
+// With -repair, problems which can be fixed without losing usable metadata are
+// repaired: dangling and missing name entries are removed or added, missing buckets
+// are created, orphaned buckets and undecodable partitions are removed, missing or
+// undecodable catalog records are replaced by records without description, ID
+// index entries are fixed and missing current schema versions are recorded.
+// Undecodable database and table records are only reported, since removing them
+// would also remove everything they contain. Partitions stored under wrong keys are
+// moved only when no other partition occupies or moves to the same key.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+)
+
+// fsckProblem describes a single inconsistency
+type fsckProblem struct {
+	path    string       // Location of the problem
+	problem string       // Description
+	fix     func() error // Repair action, nil if the problem can't be repaired
+}
+
+// checker collects problems found in a transaction.
+// Repairs can't be done while iterating over buckets, so they are collected and
+// applied after the check.
+type checker struct {
+	problems []fsckProblem
+}
+
+func (c *checker) report(fix func() error, path string, format string, args ...interface{}) {
+	c.problems = append(c.problems, fsckProblem{
+		path:    path,
+		problem: fmt.Sprintf(format, args...),
+		fix:     fix,
+	})
+}
+
+// Repair actions
+
+func deleteKey(b *bolt.Bucket, key []byte) func() error {
+	return func() error { return b.Delete(key) }
+}
+
+func deleteBucket(b *bolt.Bucket, key []byte) func() error {
+	return func() error { return b.DeleteBucket(key) }
+}
+
+func putKey(b *bolt.Bucket, key []byte, value []byte) func() error {
+	return func() error { return b.Put(key, value) }
+}
+
+func createBuckets(b *bolt.Bucket, key []byte, children ...string) func() error {
+	return func() error {
+		bucket, err := b.CreateBucketIfNotExists(key)
+		if err != nil {
+			return err
+		}
+		for _, name := range children {
+			if _, err = bucket.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func putCatalogRecord(b *bolt.Bucket, cat *pb.Catalog) func() error {
+	return func() error { return putCatalog(b, cat) }
+}
+
+func putSchemaRecord(dbBucket *bolt.Bucket, tableID []byte, schema *pb.TableSchema) func() error {
+	return func() error { return putTableSchema(dbBucket, tableID, schema) }
+}
+
+func moveKey(b *bolt.Bucket, from []byte, to []byte, value []byte) func() error {
+	return func() error {
+		if err := b.Put(to, value); err != nil {
+			return err
+		}
+		return b.Delete(from)
+	}
+}
+
+// copyBytes copies key or value which is only valid during the transaction iteration
+func copyBytes(b []byte) []byte {
+	return append([]byte(nil), b...)
+}
+
+// sortedKeys returns map keys in sorted order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// requireBuckets checks that bucket has all specified sub-buckets.
+// It returns false if any of them is missing.
+func (c *checker) requireBuckets(path string, b *bolt.Bucket, names ...string) bool {
+	ok := true
+	for _, name := range names {
+		if b.Bucket([]byte(name)) == nil {
+			c.report(createBuckets(b, []byte(name)), path, "missing %s bucket", name)
+			ok = false
+		}
+	}
+	return ok
+}
+
+// checkObjects verifies name and ID maps of databases in a catalog or tables in
+// a database and checks that every object has its data bucket.
+//
+//   path - location of the maps
+//   kind - object kind
+//   nameMap, idMap - BYNAME and BYID buckets
+//   dataBuckets - bucket with per-object buckets keyed by ID
+//   children - sub-buckets expected in the object bucket
+//   decode - decodes object record and returns its ID
+//
+// Returns names of valid objects keyed by ID.
+func (c *checker) checkObjects(path string, kind string, nameMap *bolt.Bucket,
+	idMap *bolt.Bucket, dataBuckets *bolt.Bucket, children []string,
+	decode func([]byte) (*pb.Id, error)) map[string]string {
+	valid := make(map[string]string)
+	corrupt := make(map[string]bool)
+	idMap.ForEach(func(k, v []byte) error {
+		if v == nil {
+			c.report(nil, path, "unexpected bucket %s in %s", k, byIDHdr)
+			return nil
+		}
+		id, err := decode(v)
+		if err != nil {
+			c.report(nil, path, "%s %s can't be decoded: %v", kind, k, err)
+			corrupt[string(k)] = true
+			return nil
+		}
+		if id.GetId() != string(k) {
+			c.report(nil, path, "%s %s is stored under ID %s", kind, id.GetId(), k)
+		}
+		valid[string(k)] = id.GetName()
+		return nil
+	})
+
+	named := make(map[string]bool)
+	nameMap.ForEach(func(k, v []byte) error {
+		if v == nil {
+			c.report(nil, path, "unexpected bucket %s in %s", k, bynameHdr)
+			return nil
+		}
+		name, ok := valid[string(v)]
+		switch {
+		case corrupt[string(v)]:
+			// Already reported
+		case !ok:
+			c.report(deleteKey(nameMap, copyBytes(k)), path,
+				"%s %s refers to missing ID %s", kind, k, v)
+		case name != string(k):
+			c.report(deleteKey(nameMap, copyBytes(k)), path,
+				"%s %s refers to ID %s named %s", kind, k, v, name)
+		default:
+			named[string(v)] = true
+		}
+		return nil
+	})
+
+	for _, id := range sortedKeys(valid) {
+		name := valid[id]
+		if !named[id] {
+			if other := nameMap.Get([]byte(name)); other != nil && valid[string(other)] == name {
+				c.report(nil, path, "%s %s with ID %s duplicates name of %s", kind, name, id, other)
+			} else {
+				c.report(putKey(nameMap, []byte(name), []byte(id)), path,
+					"%s %s with ID %s has no name entry", kind, name, id)
+			}
+		}
+		if dataBuckets.Bucket([]byte(id)) == nil {
+			c.report(createBuckets(dataBuckets, []byte(id), children...), path,
+				"%s %s has no data bucket %s", kind, name, id)
+		}
+	}
+
+	dataBuckets.ForEach(func(k, v []byte) error {
+		if v != nil {
+			c.report(deleteKey(dataBuckets, copyBytes(k)), path, "unexpected key %s", k)
+			return nil
+		}
+		if _, ok := valid[string(k)]; !ok && !corrupt[string(k)] {
+			c.report(deleteBucket(dataBuckets, copyBytes(k)), path,
+				"orphaned %s bucket %s", kind, k)
+		}
+		return nil
+	})
+	return valid
+}
+
+// checkCatalogRecord verifies that catalog record exists and refers to the catalog.
+func (c *checker) checkCatalogRecord(catalog string, catBucket *bolt.Bucket) {
+	data := catBucket.Get([]byte(catalogHdr))
+	if data == nil {
+		c.report(putCatalogRecord(catBucket, &pb.Catalog{Name: catalog}), catalog,
+			"missing %s record", catalogHdr)
+		return
+	}
+	var cat pb.Catalog
+	if err := proto.Unmarshal(data, &cat); err != nil {
+		c.report(putCatalogRecord(catBucket, &pb.Catalog{Name: catalog}), catalog,
+			"%s record can't be decoded: %v", catalogHdr, err)
+		return
+	}
+	if cat.Name != catalog {
+		name := cat.Name
+		cat.Name = catalog
+		c.report(putCatalogRecord(catBucket, &cat), catalog,
+			"%s record has name %s", catalogHdr, name)
+	}
+}
+
+// checkIDIndex verifies that ID index has entries for all objects and nothing else
+func (c *checker) checkIDIndex(catalog string, catBucket *bolt.Bucket) {
+	idx := catBucket.Bucket([]byte(idxHdr))
+	if idx == nil {
+		c.report(func() error { return indexCatalog(catBucket) }, catalog,
+			"missing %s bucket", idxHdr)
+		return
+	}
+	expected := buildIDIndex(catBucket)
+	idx.ForEach(func(k, v []byte) error {
+		loc, ok := expected[string(k)]
+		if !ok {
+			c.report(deleteKey(idx, copyBytes(k)), catalog,
+				"%s entry %s refers to missing object", idxHdr, k)
+			return nil
+		}
+		delete(expected, string(k))
+		data, err := json.Marshal(loc)
+		if err == nil && !bytes.Equal(data, v) {
+			c.report(putKey(idx, copyBytes(k), data), catalog,
+				"%s entry %s is %s instead of %s", idxHdr, k, v, data)
+		}
+		return nil
+	})
+	ids := make([]string, 0, len(expected))
+	for id := range expected {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		data, err := json.Marshal(expected[id])
+		if err != nil {
+			continue
+		}
+		c.report(putKey(idx, []byte(id), data), catalog,
+			"%s %s has no %s entry", expected[id].Kind, id, idxHdr)
+	}
+}
+
+func (c *checker) checkCatalog(catalog string, catBucket *bolt.Bucket) {
+	c.checkCatalogRecord(catalog, catBucket)
+	if !c.requireBuckets(catalog, catBucket, bynameHdr, byIDHdr, dbHdr) {
+		return
+	}
+	c.checkIDIndex(catalog, catBucket)
+	dbBuckets := catBucket.Bucket([]byte(dbHdr))
+	databases := c.checkObjects(catalog, "database", catBucket.Bucket([]byte(bynameHdr)),
+		catBucket.Bucket([]byte(byIDHdr)), dbBuckets,
+		[]string{bynameHdr, byIDHdr, tblsHdr},
+		func(data []byte) (*pb.Id, error) {
+			var database pb.Database
+			err := proto.Unmarshal(data, &database)
+			return database.Id, err
+		})
+	for _, id := range sortedKeys(databases) {
+		name := databases[id]
+		if dbBucket := dbBuckets.Bucket([]byte(id)); dbBucket != nil {
+			c.checkDatabase(catalog+"/"+name, dbBucket)
+		}
+	}
+}
+
+func (c *checker) checkDatabase(path string, dbBucket *bolt.Bucket) {
+	if !c.requireBuckets(path, dbBucket, bynameHdr, byIDHdr, tblsHdr) {
+		return
+	}
+	tblBuckets := dbBucket.Bucket([]byte(tblsHdr))
+	tables := c.checkObjects(path, "table", dbBucket.Bucket([]byte(bynameHdr)),
+		dbBucket.Bucket([]byte(byIDHdr)), tblBuckets, nil,
+		func(data []byte) (*pb.Id, error) {
+			var table pb.Table
+			err := proto.Unmarshal(data, &table)
+			return table.Id, err
+		})
+	for _, id := range sortedKeys(tables) {
+		name := tables[id]
+		var table pb.Table
+		if err := proto.Unmarshal(dbBucket.Bucket([]byte(byIDHdr)).Get([]byte(id)), &table); err != nil {
+			continue
+		}
+		if partBucket := tblBuckets.Bucket([]byte(id)); partBucket != nil {
+			c.checkPartitions(path+"."+name, &table, partBucket)
+		}
+		c.checkSchema(path+"."+name, dbBucket, []byte(id), &table)
+	}
+	c.checkOrphanedSchemas(path, dbBucket)
+}
+
+// checkSchema verifies that the current table schema version is in the history
+func (c *checker) checkSchema(path string, dbBucket *bolt.Bucket, tableID []byte, table *pb.Table) {
+	if table.SchemaVersion == 0 {
+		c.report(nil, path, "table has no schema version")
+		return
+	}
+	if _, err := getTableSchema(dbBucket, tableID, table.SchemaVersion); err != nil {
+		c.report(putSchemaRecord(dbBucket, tableID, tableSchema(table)), path, "%v", err)
+	}
+}
+
+// checkOrphanedSchemas finds schema history of missing tables
+func (c *checker) checkOrphanedSchemas(path string, dbBucket *bolt.Bucket) {
+	schemas := dbBucket.Bucket([]byte(schemasHdr))
+	if schemas == nil {
+		return
+	}
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	schemas.ForEach(func(k, v []byte) error {
+		if v != nil {
+			c.report(deleteKey(schemas, copyBytes(k)), path, "unexpected key %s in %s", k, schemasHdr)
+		} else if byIDBucket.Get(k) == nil {
+			c.report(deleteBucket(schemas, copyBytes(k)), path, "orphaned schema history %s", k)
+		}
+		return nil
+	})
+}
+
+func (c *checker) checkPartitions(path string, table *pb.Table, partBucket *bolt.Bucket) {
+	type move struct {
+		name        string
+		oldKey, key []byte
+		value       []byte
+		alsoStored  bool
+	}
+	var moves []*move
+	targets := make(map[string]int)
+	partBucket.ForEach(func(k, v []byte) error {
+		if v == nil {
+			c.report(nil, path, "unexpected bucket %s", k)
+			return nil
+		}
+		var partition pb.Partition
+		if err := proto.Unmarshal(v, &partition); err != nil {
+			c.report(deleteKey(partBucket, copyBytes(k)), path,
+				"partition %x can't be decoded: %v", k, err)
+			return nil
+		}
+		key, err := partitionKey(table, partition.Values)
+		if err != nil {
+			c.report(nil, path, "partition %q: %v", partition.Values, err)
+			return nil
+		}
+		if bytes.Equal(key, k) {
+			return nil
+		}
+		name, _ := partitionName(table, partition.Values)
+		moves = append(moves, &move{name, copyBytes(k), key, copyBytes(v), partBucket.Get(key) != nil})
+		targets[string(key)]++
+		return nil
+	})
+	// A partition is moved only to a key which no other record occupies or moves to,
+	// so repairs never replace another partition.
+	for _, m := range moves {
+		switch {
+		case m.alsoStored:
+			c.report(nil, path, "partition %s is also stored under %x", m.name, m.oldKey)
+		case targets[string(m.key)] > 1:
+			c.report(nil, path, "partition %s is stored under %x and collides with another partition",
+				m.name, m.oldKey)
+		default:
+			c.report(moveKey(partBucket, m.oldKey, m.key, m.value), path,
+				"partition %s is stored under %x", m.name, m.oldKey)
+		}
+	}
+}
+
+// check verifies all catalogs
+func (c *checker) check(tx *bolt.Tx) {
+	forEachCatalog(tx, func(name []byte, b *bolt.Bucket) error {
+		c.checkCatalog(string(name), b)
+		return nil
+	})
+}
+
+// fsckCommand checks and optionally repairs the database.
+func fsckCommand(args []string) error {
+	fs := flag.NewFlagSet("fsck", flag.ContinueOnError)
+	repair := fs.Bool("repair", false, "Repair problems which can be fixed")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	db, err := openMetastore(!*repair)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	c := new(checker)
+	repaired := 0
+	run := db.View
+	if *repair {
+		run = db.Update
+	}
+	err = run(func(tx *bolt.Tx) error {
+		c.check(tx)
+		if !*repair {
+			return nil
+		}
+		for _, p := range c.problems {
+			if p.fix == nil {
+				continue
+			}
+			if err := p.fix(); err != nil {
+				return fmt.Errorf("%s: failed to repair %s: %v", p.path, p.problem, err)
+			}
+			repaired++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range c.problems {
+		entry := log.WithField("path", p.path)
+		switch {
+		case *repair && p.fix != nil:
+			entry.Info("repaired: ", p.problem)
+		case p.fix != nil:
+			entry.Warn(p.problem)
+		default:
+			entry.Warn(p.problem, " (needs manual repair)")
+		}
+	}
+	if len(c.problems) > repaired {
+		return fmt.Errorf("%d problems found, %d repaired", len(c.problems), repaired)
+	}
+	log.WithFields(log.Fields{
+		"found":    len(c.problems),
+		"repaired": repaired,
+	}).Info("check completed")
+	return nil
+}
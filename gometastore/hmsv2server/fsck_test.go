@@ -0,0 +1,119 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestCheckPartitionsRepair(t *testing.T) {
+	table := testTable("hr", "int")
+	typedKey := func(value string) string {
+		key, err := partitionKey(table, []string{value})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(key)
+	}
+	part := func(id string, value string) *pb.Partition {
+		return &pb.Partition{Id: &pb.Id{Id: id}, Values: []string{value}}
+	}
+
+	tests := []struct {
+		name   string
+		before map[string]*pb.Partition
+		after  map[string]string // key -> ID of the partition stored there
+		fixes  int
+	}{
+		{
+			name:   "move",
+			before: map[string]*pb.Partition{"hr=8": part("p8", "8")},
+			after:  map[string]string{typedKey("8"): "p8"},
+			fixes:  1,
+		},
+		{
+			name: "moves to the same key",
+			before: map[string]*pb.Partition{
+				"hr=01": part("p01", "01"),
+				"hr=1":  part("p1", "1"),
+				"hr=2":  part("p2", "2"),
+			},
+			after: map[string]string{"hr=01": "p01", "hr=1": "p1", typedKey("2"): "p2"},
+			fixes: 1,
+		},
+		{
+			name: "move to occupied key",
+			before: map[string]*pb.Partition{
+				typedKey("5"): part("p5", "5"),
+				"hr=05":       part("p05", "05"),
+			},
+			after: map[string]string{typedKey("5"): "p5", "hr=05": "p05"},
+		},
+		{
+			// The key of 6 is occupied by a misfiled partition, which is moved away
+			// only by the same repair
+			name: "move to key of moved partition",
+			before: map[string]*pb.Partition{
+				typedKey("6"): part("p7", "7"),
+				"hr=6":        part("p6", "6"),
+			},
+			after: map[string]string{typedKey("7"): "p7", "hr=6": "p6"},
+			fixes: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDB(t)
+			err := db.Update(func(tx *bolt.Tx) error {
+				partBucket, err := tx.CreateBucket([]byte("parts"))
+				if err != nil {
+					return err
+				}
+				for key, partition := range tt.before {
+					if err := partBucket.Put([]byte(key), mustMarshal(t, partition)); err != nil {
+						return err
+					}
+				}
+				var c checker
+				c.checkPartitions("parts", table, partBucket)
+				fixes := 0
+				for _, p := range c.problems {
+					if p.fix == nil {
+						continue
+					}
+					fixes++
+					if err := p.fix(); err != nil {
+						return err
+					}
+				}
+				if fixes != tt.fixes {
+					t.Errorf("%d repairs, want %d: %v", fixes, tt.fixes, c.problems)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			db.View(func(tx *bolt.Tx) error {
+				partBucket := tx.Bucket([]byte("parts"))
+				if n := partBucket.Stats().KeyN; n != len(tt.after) {
+					t.Errorf("%d partitions after repair, want %d", n, len(tt.after))
+				}
+				for key, id := range tt.after {
+					var partition pb.Partition
+					data := partBucket.Get([]byte(key))
+					if data == nil || proto.Unmarshal(data, &partition) != nil {
+						t.Errorf("missing partition %s under %q", id, key)
+						continue
+					}
+					if got := partition.GetId().GetId(); got != id {
+						t.Errorf("partition %s under %q, want %s", got, key, id)
+					}
+				}
+				return nil
+			})
+		})
+	}
+}
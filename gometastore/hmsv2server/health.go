@@ -0,0 +1,69 @@
+// Health checking and shutdown
+//
+// The server implements grpc.health.v1 for the overall server ("") and for the
+// metastore.Metastore service. Both report SERVING once the database is open and
+// switch to NOT_SERVING when shutdown starts, so load balancers stop sending new
+// requests while in-flight ones complete.
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	healthServicePrefix = "/grpc.health.v1.Health/"
+	metastoreService    = "metastore.Metastore"
+)
+
+// isHealthCheck returns true for methods of the health service which are available
+// without authentication and are not logged.
+func isHealthCheck(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, healthServicePrefix)
+}
+
+// registerHealth registers health service and marks the server as serving.
+func registerHealth(grpcServer *grpc.Server) *health.Server {
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus(metastoreService, healthpb.HealthCheckResponse_SERVING)
+	return healthServer
+}
+
+// handleShutdown waits for SIGINT or SIGTERM and stops the server gracefully.
+// In-flight calls are given timeout to complete, after which remaining calls are
+// cancelled. The returned channel is closed when the server is fully stopped.
+func handleShutdown(grpcServer *grpc.Server, healthServer *health.Server,
+	timeout time.Duration) <-chan struct{} {
+	done := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		defer close(done)
+		sig := <-signals
+		log.WithField("signal", sig.String()).Info("shutting down")
+		healthServer.Shutdown()
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(timeout):
+			log.Warn("graceful shutdown timed out, cancelling remaining calls")
+			grpcServer.Stop()
+		}
+	}()
+	return done
+}
@@ -0,0 +1,338 @@
+// Object ID index
+//
+// Every catalog has an IDX bucket which maps IDs of its databases, tables and
+// partitions to their location. Locations refer to parents by ID rather than by name,
+// so they stay valid when objects are renamed:
+//
+//   IDX+
+//      <db id>   -> {"kind":"database"}
+//      <tbl id>  -> {"kind":"table","db_id":"<db id>"}
+//      <part id> -> {"kind":"partition","table_id":"<tbl id>","values":[...]}
+//
+// Partitions refer to their table only, the database is found through the table entry.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+)
+
+const idxHdr = "IDX"
+
+// idLocation is the value of the ID index entry
+type idLocation struct {
+	Kind    string   `json:"kind"` // kindDatabase, kindTable or kindPartition
+	DbID    string   `json:"db_id,omitempty"`
+	TableID string   `json:"table_id,omitempty"`
+	Values  []string `json:"values,omitempty"`
+}
+
+// getIDIndex returns ID index of the catalog
+func getIDIndex(catBucket *bolt.Bucket) (*bolt.Bucket, error) {
+	idx := catBucket.Bucket([]byte(idxHdr))
+	if idx == nil {
+		return nil, fmt.Errorf("corrupt catalog: no %s bucket", idxHdr)
+	}
+	return idx, nil
+}
+
+// getCatalogIDIndex returns ID index of the catalog by name
+func getCatalogIDIndex(tx *bolt.Tx, catalog string) (*bolt.Bucket, error) {
+	catBucket, err := getCatalogBucket(tx, catalog)
+	if err != nil {
+		return nil, err
+	}
+	return getIDIndex(catBucket)
+}
+
+func putIDLocation(idx *bolt.Bucket, id string, loc *idLocation) error {
+	if id == "" {
+		return nil
+	}
+	data, err := json.Marshal(loc)
+	if err != nil {
+		return err
+	}
+	return idx.Put([]byte(id), data)
+}
+
+func getIDLocation(idx *bolt.Bucket, id string) (*idLocation, error) {
+	data := idx.Get([]byte(id))
+	if data == nil {
+		return nil, notFoundError(fmt.Sprintf("object %s doesn't exist", id))
+	}
+	var loc idLocation
+	if err := json.Unmarshal(data, &loc); err != nil {
+		return nil, fmt.Errorf("corrupt %s entry for %s: %v", idxHdr, id, err)
+	}
+	return &loc, nil
+}
+
+// indexPartition adds partition ID to the index
+func indexPartition(idx *bolt.Bucket, tableID string, partition *pb.Partition) error {
+	return putIDLocation(idx, partition.GetId().GetId(), &idLocation{
+		Kind:    kindPartition,
+		TableID: tableID,
+		Values:  partition.Values,
+	})
+}
+
+// unindexPartition removes ID of the stored partition from the index
+func unindexPartition(idx *bolt.Bucket, data []byte) error {
+	var partition pb.Partition
+	if err := proto.Unmarshal(data, &partition); err != nil {
+		return err
+	}
+	if id := partition.GetId().GetId(); id != "" {
+		return idx.Delete([]byte(id))
+	}
+	return nil
+}
+
+// indexTable adds IDs of the table and all its partitions to the index
+func indexTable(idx *bolt.Bucket, dbID []byte, tableID []byte, partBucket *bolt.Bucket) error {
+	err := putIDLocation(idx, string(tableID), &idLocation{Kind: kindTable, DbID: string(dbID)})
+	if err != nil || partBucket == nil {
+		return err
+	}
+	return partBucket.ForEach(func(k, v []byte) error {
+		if v == nil {
+			return nil
+		}
+		var partition pb.Partition
+		if err := proto.Unmarshal(v, &partition); err != nil {
+			return err
+		}
+		return indexPartition(idx, string(tableID), &partition)
+	})
+}
+
+// indexDatabase adds IDs of the database and all its objects to the index
+func indexDatabase(idx *bolt.Bucket, dbID []byte, dbBucket *bolt.Bucket) error {
+	if err := putIDLocation(idx, string(dbID), &idLocation{Kind: kindDatabase}); err != nil {
+		return err
+	}
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
+	if byIDBucket == nil || tablesBucket == nil {
+		return nil
+	}
+	return byIDBucket.ForEach(func(k, v []byte) error {
+		return indexTable(idx, dbID, k, tablesBucket.Bucket(k))
+	})
+}
+
+// unindexTable removes IDs of the table and all its partitions from the index
+func unindexTable(idx *bolt.Bucket, tableID []byte, partBucket *bolt.Bucket) error {
+	if partBucket != nil {
+		err := partBucket.ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+			return unindexPartition(idx, v)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return idx.Delete(tableID)
+}
+
+// unindexDatabase removes IDs of the database and all its objects from the index
+func unindexDatabase(idx *bolt.Bucket, dbID []byte, dbBucket *bolt.Bucket) error {
+	if dbBucket != nil {
+		byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+		tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
+		if byIDBucket != nil && tablesBucket != nil {
+			err := byIDBucket.ForEach(func(k, v []byte) error {
+				return unindexTable(idx, k, tablesBucket.Bucket(k))
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return idx.Delete(dbID)
+}
+
+// buildIDIndex returns index entries for all objects of the catalog.
+// Undecodable records and missing buckets are skipped.
+func buildIDIndex(catBucket *bolt.Bucket) map[string]*idLocation {
+	entries := make(map[string]*idLocation)
+	idMap := catBucket.Bucket([]byte(byIDHdr))
+	dbBuckets := catBucket.Bucket([]byte(dbHdr))
+	if idMap == nil || dbBuckets == nil {
+		return entries
+	}
+	idMap.ForEach(func(dbID, v []byte) error {
+		entries[string(dbID)] = &idLocation{Kind: kindDatabase}
+		dbBucket := dbBuckets.Bucket(dbID)
+		if dbBucket == nil {
+			return nil
+		}
+		byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+		tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
+		if byIDBucket == nil || tablesBucket == nil {
+			return nil
+		}
+		byIDBucket.ForEach(func(tableID, v []byte) error {
+			entries[string(tableID)] = &idLocation{Kind: kindTable, DbID: string(dbID)}
+			partBucket := tablesBucket.Bucket(tableID)
+			if partBucket == nil {
+				return nil
+			}
+			partBucket.ForEach(func(k, v []byte) error {
+				var partition pb.Partition
+				if v == nil || proto.Unmarshal(v, &partition) != nil {
+					return nil
+				}
+				if id := partition.GetId().GetId(); id != "" {
+					entries[id] = &idLocation{
+						Kind:    kindPartition,
+						TableID: string(tableID),
+						Values:  partition.Values,
+					}
+				}
+				return nil
+			})
+			return nil
+		})
+		return nil
+	})
+	return entries
+}
+
+// indexCatalog creates ID index of the catalog
+func indexCatalog(catBucket *bolt.Bucket) error {
+	idx, err := catBucket.CreateBucketIfNotExists([]byte(idxHdr))
+	if err != nil {
+		return err
+	}
+	for id, loc := range buildIDIndex(catBucket) {
+		if err = putIDLocation(idx, id, loc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addIDIndexes creates ID index for every catalog
+func addIDIndexes(tx *bolt.Tx) error {
+	return forEachCatalog(tx, func(name []byte, catBucket *bolt.Bucket) error {
+		return indexCatalog(catBucket)
+	})
+}
+
+// resolveID fills response with the object identified by ID
+func resolveID(tx *bolt.Tx, catalog string, id string, resp *pb.ResolveIdResponse) error {
+	idx, err := getCatalogIDIndex(tx, catalog)
+	if err != nil {
+		return err
+	}
+	loc, err := getIDLocation(idx, id)
+	if err != nil {
+		return err
+	}
+	var tableLoc *idLocation
+	switch loc.Kind {
+	case kindDatabase:
+		resp.Kind = pb.ResolveIdResponse_KIND_DATABASE
+		resp.Database, err = getDatabase(tx, catalog, &pb.Id{Id: id})
+		if err != nil {
+			return err
+		}
+		resp.DbId = resp.Database.Id
+		return nil
+	case kindTable:
+		resp.Kind = pb.ResolveIdResponse_KIND_TABLE
+		tableLoc = loc
+		loc.TableID = id
+	case kindPartition:
+		resp.Kind = pb.ResolveIdResponse_KIND_PARTITION
+		if tableLoc, err = getIDLocation(idx, loc.TableID); err != nil {
+			return fmt.Errorf("corrupt %s entry for %s: missing table %s", idxHdr, id, loc.TableID)
+		}
+	default:
+		return fmt.Errorf("corrupt %s entry for %s: unknown kind %s", idxHdr, id, loc.Kind)
+	}
+
+	database, err := getDatabase(tx, catalog, &pb.Id{Id: tableLoc.DbID})
+	if err != nil {
+		return err
+	}
+	resp.DbId = database.Id
+	dbBucket, err := getDatabaseBucket(tx, catalog, database.Id)
+	if err != nil {
+		return err
+	}
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	if byIDBucket == nil {
+		return fmt.Errorf("corrupt catalog %s/%s: no BYID info", catalog, database.Id.Name)
+	}
+	data := byIDBucket.Get([]byte(loc.TableID))
+	if data == nil {
+		return fmt.Errorf("corrupt %s entry for %s: missing table %s", idxHdr, id, loc.TableID)
+	}
+	var table pb.Table
+	if err = proto.Unmarshal(data, &table); err != nil {
+		return fmt.Errorf("catalog corruted: can't decode table %s: %v", loc.TableID, err)
+	}
+	resp.TableId = table.Id
+	if loc.Kind == kindTable {
+		resp.Table = &table
+		return nil
+	}
+
+	partBucket, err := getTableBucket(dbBucket, catalog, database.Id.Name, table.Id.Name, false)
+	if err != nil {
+		return err
+	}
+	key, err := partitionKey(&table, loc.Values)
+	if err != nil {
+		return err
+	}
+	data = partBucket.Get(key)
+	if data == nil {
+		return fmt.Errorf("corrupt %s entry for %s: missing partition %q", idxHdr, id, loc.Values)
+	}
+	var partition pb.Partition
+	if err = proto.Unmarshal(data, &partition); err != nil {
+		return err
+	}
+	expandSd(table.Sd, &partition)
+	partition.Table = &table
+	resp.Values = partition.Values
+	resp.Partition = &partition
+	return nil
+}
+
+func (s *metastoreServer) ResolveId(c context.Context,
+	req *pb.ResolveIdRequest) (*pb.ResolveIdResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("ResolveId: ", req)
+	if req.Catalog == "" {
+		return nil, fmt.Errorf("missing catalog")
+	}
+	if req.Id == "" {
+		return nil, fmt.Errorf("missing id")
+	}
+
+	resp := new(pb.ResolveIdResponse)
+	err := s.view(c, func(tx *bolt.Tx) error {
+		return resolveID(tx, req.Catalog, req.Id, resp)
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to resolve id")
+		return &pb.ResolveIdResponse{Status: errorStatus(err)}, nil
+	}
+
+	resp.Status = &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK}
+	return resp, nil
+}
@@ -0,0 +1,124 @@
+// Listener and transport options
+
+package main
+
+import (
+	"net"
+	"os"
+	"strconv"
+
+	"context"
+	"github.com/boltdb/bolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+// listen creates Unix domain socket listener if socket path is set or TCP listener
+// on address:port otherwise.
+func listen() (net.Listener, error) {
+	if *socketPath != "" {
+		// Remove stale socket left by previous run
+		if err := os.Remove(*socketPath); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		return net.Listen("unix", *socketPath)
+	}
+	return net.Listen("tcp", net.JoinHostPort(*address, strconv.Itoa(*port)))
+}
+
+// isLoopback returns true if host:port address only accepts local connections.
+// Addresses without host listen on all interfaces.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil || host == "" {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// openDB opens Bolt database with options from flags.
+// Read-only database can be opened by multiple processes, but not while the
+// server has it open.
+func openDB(readOnly bool) (*bolt.DB, error) {
+	db, err := bolt.Open(*boltDbName, 0644, &bolt.Options{
+		Timeout:         *boltTimeout,
+		InitialMmapSize: *boltMmapSize,
+		ReadOnly:        readOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	db.NoSync = *boltNoSync
+	return db, nil
+}
+
+// transportOptions returns gRPC server options for message sizes, keepalive and
+// concurrency limits.
+func transportOptions() []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(*maxRecvMsgSize),
+		grpc.MaxSendMsgSize(*maxSendMsgSize),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    *keepaliveTime,
+			Timeout: *keepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             *keepaliveMinTime,
+			PermitWithoutStream: true,
+		}),
+	}
+	if *maxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(uint32(*maxConcurrentStreams)))
+	}
+	return opts
+}
+
+// concurrencyLimiter rejects calls with ResourceExhausted when there are too many
+// calls in progress.
+type concurrencyLimiter chan struct{}
+
+func newConcurrencyLimiter(limit int) concurrencyLimiter {
+	return make(concurrencyLimiter, limit)
+}
+
+func (l concurrencyLimiter) acquire(fullMethod string) error {
+	if isHealthCheck(fullMethod) {
+		return nil
+	}
+	select {
+	case l <- struct{}{}:
+		return nil
+	default:
+		return status.Errorf(codes.ResourceExhausted, "too many concurrent requests (limit %d)", cap(l))
+	}
+}
+
+func (l concurrencyLimiter) release(fullMethod string) {
+	if !isHealthCheck(fullMethod) {
+		<-l
+	}
+}
+
+func (l concurrencyLimiter) unaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.acquire(info.FullMethod); err != nil {
+		return nil, err
+	}
+	defer l.release(info.FullMethod)
+	return handler(ctx, req)
+}
+
+func (l concurrencyLimiter) streamInterceptor(srv interface{}, stream grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.acquire(info.FullMethod); err != nil {
+		return err
+	}
+	defer l.release(info.FullMethod)
+	return handler(srv, stream)
+}
@@ -0,0 +1,167 @@
+// Logging
+//
+// The server uses leveled structured logging. Every request gets a request ID which
+// is taken from the x-request-id metadata (set by hmsproxy) or generated, returned
+// to the client in the x-request-id header and attached to all log lines of the request.
+// A single summary line is logged for every RPC.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const requestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// configureLogging sets log level and format ("text" or "json").
+func configureLogging(level string, format string) error {
+	lvl, err := log.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	log.SetLevel(lvl)
+	log.SetOutput(os.Stderr)
+	switch format {
+	case "text":
+		log.SetFormatter(&log.TextFormatter{FullTimestamp: true})
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	default:
+		return fmt.Errorf("unknown log format %s", format)
+	}
+	return nil
+}
+
+// requestIDFromContext returns ID of the current request.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestLogger returns logger with request ID, principal and trace ID fields.
+func requestLogger(ctx context.Context) *log.Entry {
+	fields := log.Fields{}
+	if id := requestIDFromContext(ctx); id != "" {
+		fields["request_id"] = id
+	}
+	if principal := principalFromContext(ctx); principal != "" {
+		fields["principal"] = principal
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		fields["trace_id"] = sc.TraceID().String()
+	}
+	return log.WithFields(fields)
+}
+
+// requestContext attaches request ID to the context and sends it back to the client.
+func requestContext(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[requestIDHeader]) != 0 {
+		id = md[requestIDHeader][0]
+	}
+	if id == "" {
+		id = getULID()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// resultStatus returns status string for a completed call.
+func resultStatus(resp interface{}, err error) string {
+	if err != nil {
+		return status.Code(err).String()
+	}
+	st := responseStatus(resp)
+	if st == nil {
+		return pb.RequestStatus_STATUS_OK.String()
+	}
+	return st.Status.String()
+}
+
+// logSummary logs one line describing the completed call.
+func logSummary(ctx context.Context, method string, start time.Time, resp interface{},
+	err error, fields log.Fields) {
+	entry := requestLogger(ctx).WithFields(fields).WithFields(log.Fields{
+		"method":   method,
+		"duration": time.Since(start).String(),
+		"status":   resultStatus(resp, err),
+	})
+	if err != nil {
+		entry.WithError(err).Warn("request failed")
+		return
+	}
+	entry.Info("request completed")
+}
+
+// The request ID interceptors should be installed first, so that later interceptors
+// and handlers see the request ID.
+func requestIDUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(requestContext(ctx), req)
+}
+
+func requestIDStreamInterceptor(srv interface{}, stream grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &wrappedStream{stream, requestContext(stream.Context())})
+}
+
+func loggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	if err == nil && isHealthCheck(info.FullMethod) {
+		// Health probes are too frequent to log
+		return resp, err
+	}
+	logSummary(ctx, path.Base(info.FullMethod), start, resp, err, nil)
+	return resp, err
+}
+
+// countingStream counts messages sent and received over the stream
+type countingStream struct {
+	grpc.ServerStream
+	sent     int
+	received int
+}
+
+func (s *countingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+	}
+	return err
+}
+
+func (s *countingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+	}
+	return err
+}
+
+func loggingStreamInterceptor(srv interface{}, stream grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	s := &countingStream{ServerStream: stream}
+	err := handler(srv, s)
+	if err == nil && isHealthCheck(info.FullMethod) {
+		return err
+	}
+	logSummary(stream.Context(), path.Base(info.FullMethod), start, nil, err,
+		log.Fields{"sent": s.sent, "received": s.received})
+	return err
+}
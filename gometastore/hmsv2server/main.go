@@ -6,35 +6,251 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"flag"
-	"fmt"
-	"log"
-	"net"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"time"
 
-	"github.com/boltdb/bolt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
 
+	"github.com/akolb1/hmsv2api/gometastore/audit"
 	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/akolb1/hmsv2api/gometastore/tlsutil"
+	"github.com/akolb1/hmsv2api/gometastore/tracing"
 )
 
 var (
+	configFile = flag.String("config", "", "YAML or TOML config file, also set by "+envName(configFlag))
+	address    = flag.String("address", "localhost", "Address to bind to")
+	socketPath = flag.String("socket", "", "Listen on Unix domain socket instead of TCP")
 	port       = flag.Int("port", 10010, "The server port")
 	boltDbName = flag.String("dbname", "hms2.db", "db name")
+	certFile   = flag.String("cert", "", "TLS certificate file, enables TLS")
+	keyFile    = flag.String("key", "", "TLS key file")
+	clientCA   = flag.String("clientca", "",
+		"CA certificates for client authentication, enables mutual TLS")
+	jwtKeyFile  = flag.String("jwt-key", "", "HMAC key file for bearer tokens, enables token auth")
+	jwksFile    = flag.String("jwks", "", "JWKS file for bearer tokens, enables token auth")
+	jwtIssuer   = flag.String("jwt-issuer", "", "Required token issuer")
+	jwtAud      = flag.String("jwt-audience", "", "Required token audience")
+	jwtClaim    = flag.String("jwt-principal-claim", "sub", "Token claim used as principal")
+	logLevel    = flag.String("log-level", "info", "Log level: debug, info, warn, error")
+	logFormat   = flag.String("log-format", "text", "Log format: text or json")
+	metricsAddr = flag.String("metrics", "", "Address for HTTP /metrics endpoint, e.g. localhost:10080")
+	objectsTTL  = flag.Duration("metrics-objects-ttl", time.Minute,
+		"How long object counts exported as metrics are cached")
+
+	boltTimeout  = flag.Duration("bolt-timeout", 0, "Time to wait for database file lock, 0 waits forever")
+	boltNoSync   = flag.Bool("bolt-nosync", false, "Skip fsync after each commit, unsafe")
+	boltMmapSize = flag.Int("bolt-mmap-size", 0, "Initial mmap size of the database in bytes")
+
+	maxRecvMsgSize       = flag.Int("max-recv-msg-size", 4<<20, "Maximum size of received message in bytes")
+	maxSendMsgSize       = flag.Int("max-send-msg-size", math.MaxInt32, "Maximum size of sent message in bytes")
+	keepaliveTime        = flag.Duration("keepalive-time", 2*time.Hour, "Ping idle clients after this time")
+	keepaliveTimeout     = flag.Duration("keepalive-timeout", 20*time.Second, "Close connection if ping is not answered in this time")
+	keepaliveMinTime     = flag.Duration("keepalive-min-time", 5*time.Minute, "Minimum allowed interval between client pings")
+	maxConcurrentStreams = flag.Int("max-concurrent-streams", 0, "Maximum concurrent calls per connection, 0 is unlimited")
+	maxConcurrentCalls   = flag.Int("max-concurrent-calls", 0, "Maximum concurrent calls per server, 0 is unlimited")
+
+	adminAddr        = flag.String("admin", "", "Address for admin HTTP endpoints (/backup), e.g. localhost:10081")
+	snapshotDir      = flag.String("snapshot-dir", "", "Directory for periodic database snapshots")
+	snapshotInterval = flag.Duration("snapshot-interval", time.Hour, "Interval between snapshots")
+	snapshotKeep     = flag.Int("snapshot-keep", 24, "Number of snapshots to keep, 0 keeps all")
+
+	trashRetention     = flag.Duration("trash-retention", 24*time.Hour, "Time dropped tables and databases are kept in trash, 0 disables trash")
+	trashPurgeInterval = flag.Duration("trash-purge-interval", 10*time.Minute, "Interval between purges of expired objects from trash, 0 disables purging")
+
+	shutdownTimeout = flag.Duration("shutdown-timeout", 30*time.Second,
+		"Time given to in-flight calls to complete on shutdown")
+
+	defaultCatalog = flag.String("default-catalog", "hive", "Catalog created at startup if missing, none if empty")
+
+	traceExporter = flag.String("trace-exporter", "", "Trace exporter: otlp or file, tracing is disabled if empty")
+	traceEndpoint = flag.String("trace-endpoint", "", "OTLP collector endpoint, e.g. localhost:4317")
+	traceInsecure = flag.Bool("trace-insecure", false, "Use plaintext connection to OTLP collector")
+	traceFile     = flag.String("trace-file", "hmsv2server-traces.json", "Output file for file trace exporter")
+	traceRatio    = flag.Float64("trace-ratio", 1.0, "Fraction of sampled traces")
+
+	auditFile       = flag.String("audit-file", "", "Audit log file")
+	auditMaxSize    = flag.Int64("audit-max-size", 100, "Rotate audit log after this many MB")
+	auditMaxBackups = flag.Int("audit-max-backups", 10, "Number of rotated audit logs to keep")
+	auditStdout     = flag.Bool("audit-stdout", false, "Write audit log to stdout")
+	auditRedact     = flag.String("audit-redact", strings.Join(audit.DefaultRedactPatterns, ","),
+		"Comma-separated patterns of parameter keys with redacted values in audit log")
 )
 
 func main() {
+	flag.Usage = usage
 	flag.Parse()
-	db, err := bolt.Open(*boltDbName, 0644, nil)
+	config := *configFile
+	if config == "" {
+		config = os.Getenv(envName(configFlag))
+	}
+	if err := loadConfig(flag.CommandLine, config); err != nil {
+		log.Fatal("failed to load configuration: ", err)
+	}
+	if err := configureLogging(*logLevel, *logFormat); err != nil {
+		log.Fatal("failed to configure logging: ", err)
+	}
+	if flag.NArg() != 0 {
+		if err := runCommand(flag.Args()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *snapshotDir != "" && *snapshotInterval <= 0 {
+		log.Fatal("invalid snapshot interval: ", *snapshotInterval)
+	}
+	if *trashPurgeInterval < 0 {
+		log.Fatal("invalid trash purge interval: ", *trashPurgeInterval)
+	}
+	db, err := openMetastore(false)
 	if err != nil {
-		log.Fatal("failed to open db:", err)
+		log.Fatal("failed to open db: ", err)
 	}
 	defer db.Close()
-	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", *port))
+	if *defaultCatalog != "" {
+		if err := ensureCatalog(db, *defaultCatalog); err != nil {
+			log.Fatal("failed to create default catalog: ", err)
+		}
+	}
+	lis, err := listen()
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	var opts []grpc.ServerOption
+	log.WithField("address", lis.Addr().String()).Info("listening")
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Config{
+		Service:  "hmsv2server",
+		Exporter: *traceExporter,
+		Endpoint: *traceEndpoint,
+		Insecure: *traceInsecure,
+		File:     *traceFile,
+		Ratio:    *traceRatio,
+	})
+	if err != nil {
+		log.Fatal("failed to configure tracing: ", err)
+	}
+	defer shutdownTracing(context.Background())
+	var tlsConfig *tls.Config
+	if *certFile != "" {
+		if tlsConfig, err = tlsutil.ServerConfig(*certFile, *keyFile, *clientCA); err != nil {
+			log.Fatal("failed to configure TLS: ", err)
+		}
+	} else if *clientCA != "" {
+		log.Fatal("mutual TLS requires server certificate")
+	}
+	var verifier *tokenVerifier
+	if *jwtKeyFile != "" || *jwksFile != "" {
+		verifier, err = newTokenVerifier(*jwtKeyFile, *jwksFile, *jwtIssuer, *jwtAud, *jwtClaim)
+		if err != nil {
+			log.Fatal("failed to configure token auth: ", err)
+		}
+	}
+	if *adminAddr != "" {
+		// The admin listener serves the whole database, so it is only left
+		// unauthenticated on loopback addresses
+		if verifier == nil && *clientCA == "" && !isLoopback(*adminAddr) {
+			log.Fatal("admin listener on non-loopback address requires mutual TLS or token auth")
+		}
+		adminMux := http.NewServeMux()
+		var backup http.Handler = backupHandler(db)
+		if verifier != nil {
+			backup = verifier.httpHandler(backup)
+		}
+		adminMux.Handle("/backup", backup)
+		admin := &http.Server{Addr: *adminAddr, Handler: adminMux, TLSConfig: tlsConfig}
+		go func() {
+			if tlsConfig != nil {
+				log.Fatal(admin.ListenAndServeTLS("", ""))
+			}
+			log.Fatal(admin.ListenAndServe())
+		}()
+	}
+	if *snapshotDir != "" {
+		stopSnapshots := make(chan struct{})
+		defer close(stopSnapshots)
+		go runSnapshots(db, *snapshotDir, *snapshotInterval, *snapshotKeep, stopSnapshots)
+	}
+	// Objects dropped with earlier settings expire even if trash is disabled now
+	if *trashPurgeInterval > 0 {
+		stopPurger := make(chan struct{})
+		defer close(stopPurger)
+		go runTrashPurger(db, *trashPurgeInterval, stopPurger)
+	}
+	opts := transportOptions()
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	unaryInterceptors = append(unaryInterceptors, requestIDUnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, requestIDStreamInterceptor)
+	if *maxConcurrentCalls > 0 {
+		limiter := newConcurrencyLimiter(*maxConcurrentCalls)
+		unaryInterceptors = append(unaryInterceptors, limiter.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, limiter.streamInterceptor)
+	}
+	if *metricsAddr != "" {
+		prometheus.MustRegister(newBoltCollector(db, *objectsTTL))
+		http.Handle("/metrics", promhttp.Handler())
+		go func() {
+			log.Fatal(http.ListenAndServe(*metricsAddr, nil))
+		}()
+		unaryInterceptors = append(unaryInterceptors, metricsUnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, metricsStreamInterceptor)
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		unaryInterceptors = append(unaryInterceptors, tlsUnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, tlsStreamInterceptor)
+	}
+	if verifier != nil {
+		unaryInterceptors = append(unaryInterceptors, verifier.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, verifier.streamInterceptor)
+	}
+	unaryInterceptors = append(unaryInterceptors, loggingUnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, loggingStreamInterceptor)
+	var sinks []audit.Sink
+	if *auditFile != "" {
+		sink, err := audit.NewFileSink(*auditFile, *auditMaxSize<<20, *auditMaxBackups)
+		if err != nil {
+			log.Fatal("failed to open audit log: ", err)
+		}
+		sinks = append(sinks, sink)
+	}
+	if *auditStdout {
+		sinks = append(sinks, audit.NewStreamSink(os.Stdout, "hmsv2server"))
+	}
+	if len(sinks) != 0 {
+		auditor := audit.NewAuditor(strings.Split(*auditRedact, ","), sinks...)
+		defer auditor.Close()
+		unaryInterceptors = append(unaryInterceptors, auditUnaryInterceptor(auditor))
+		streamInterceptors = append(streamInterceptors, auditStreamInterceptor(auditor))
+	}
+	if *traceExporter != "" {
+		opts = append(opts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
+		streamInterceptors = append(streamInterceptors, tracingStreamInterceptor)
+	}
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...))
 	grpcServer := grpc.NewServer(opts...)
-	pb.RegisterMetastoreServer(grpcServer, newServer(db))
-	grpcServer.Serve(lis)
+	pb.RegisterMetastoreServer(grpcServer, newServer(db, *trashRetention))
+	healthServer := registerHealth(grpcServer)
+	reflection.Register(grpcServer)
+	done := handleShutdown(grpcServer, healthServer, *shutdownTimeout)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Error("failed to serve: ", err)
+		return
+	}
+	// Wait for in-flight calls before closing the database
+	<-done
+	log.Info("server stopped")
 }
@@ -0,0 +1,242 @@
+// Prometheus metrics
+//
+// The server exports per-RPC request counts, latencies and errors, stream message
+// counts, Bolt database statistics and number of objects in each catalog. Counting
+// objects reads every partition bucket, so the counts are cached between scrapes.
+
+package main
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const metricsNamespace = "hms"
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "requests_total",
+		Help:      "Number of RPC requests",
+	}, []string{"method"})
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_duration_seconds",
+		Help:      "RPC latency",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10),
+	}, []string{"method"})
+	requestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_errors_total",
+		Help:      "Number of failed RPC requests by RequestStatus or gRPC code",
+	}, []string{"method", "status"})
+	streamMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "stream_messages_total",
+		Help:      "Number of messages sent or received over streams",
+	}, []string{"method", "direction"})
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal, requestDuration, requestErrors, streamMessages)
+}
+
+// observeRequest records result of a single RPC call.
+func observeRequest(method string, start time.Time, resp interface{}, err error) {
+	requestsTotal.WithLabelValues(method).Inc()
+	requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		requestErrors.WithLabelValues(method, status.Code(err).String()).Inc()
+		return
+	}
+	if st := responseStatus(resp); st != nil && st.Status != pb.RequestStatus_STATUS_OK {
+		requestErrors.WithLabelValues(method, st.Status.String()).Inc()
+	}
+}
+
+func metricsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeRequest(path.Base(info.FullMethod), start, resp, err)
+	return resp, err
+}
+
+// metricsStream counts messages passing through the stream
+type metricsStream struct {
+	grpc.ServerStream
+	sent     prometheus.Counter
+	received prometheus.Counter
+}
+
+func (s *metricsStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent.Inc()
+	}
+	return err
+}
+
+func (s *metricsStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received.Inc()
+	}
+	return err
+}
+
+func metricsStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	method := path.Base(info.FullMethod)
+	start := time.Now()
+	err := handler(srv, &metricsStream{
+		ServerStream: stream,
+		sent:         streamMessages.WithLabelValues(method, "sent"),
+		received:     streamMessages.WithLabelValues(method, "received"),
+	})
+	observeRequest(method, start, nil, err)
+	return err
+}
+
+// catalogObjects is the number of objects in a catalog
+type catalogObjects struct {
+	catalog                       string
+	databases, tables, partitions int
+}
+
+// boltCollector exports Bolt statistics and per-catalog object counts.
+type boltCollector struct {
+	db         *bolt.DB
+	objectsTTL time.Duration // How long object counts are cached
+
+	mu      sync.Mutex
+	counted time.Time // When counts were taken, zero if never
+	counts  []catalogObjects
+
+	txTotal       *prometheus.Desc
+	openTx        *prometheus.Desc
+	freePages     *prometheus.Desc
+	pendingPages  *prometheus.Desc
+	freeAlloc     *prometheus.Desc
+	pageAllocs    *prometheus.Desc
+	pageAllocSize *prometheus.Desc
+	writeTime     *prometheus.Desc
+	objects       *prometheus.Desc
+}
+
+func newBoltCollector(db *bolt.DB, objectsTTL time.Duration) *boltCollector {
+	name := func(n string) string { return prometheus.BuildFQName(metricsNamespace, "bolt", n) }
+	return &boltCollector{
+		db:            db,
+		objectsTTL:    objectsTTL,
+		txTotal:       prometheus.NewDesc(name("tx_total"), "Number of started read transactions", nil, nil),
+		openTx:        prometheus.NewDesc(name("open_tx"), "Number of open read transactions", nil, nil),
+		freePages:     prometheus.NewDesc(name("free_pages"), "Number of free pages", nil, nil),
+		pendingPages:  prometheus.NewDesc(name("pending_pages"), "Number of pending pages", nil, nil),
+		freeAlloc:     prometheus.NewDesc(name("free_alloc_bytes"), "Bytes allocated in free pages", nil, nil),
+		pageAllocs:    prometheus.NewDesc(name("page_allocations_total"), "Number of page allocations", nil, nil),
+		pageAllocSize: prometheus.NewDesc(name("page_alloc_bytes_total"), "Bytes allocated for pages", nil, nil),
+		writeTime:     prometheus.NewDesc(name("write_seconds_total"), "Time spent writing to disk", nil, nil),
+		objects: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "", "objects"),
+			"Number of objects in catalog", []string{"catalog", "type"}, nil),
+	}
+}
+
+func (c *boltCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.txTotal
+	ch <- c.openTx
+	ch <- c.freePages
+	ch <- c.pendingPages
+	ch <- c.freeAlloc
+	ch <- c.pageAllocs
+	ch <- c.pageAllocSize
+	ch <- c.writeTime
+	ch <- c.objects
+}
+
+func (c *boltCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.db.Stats()
+	ch <- prometheus.MustNewConstMetric(c.txTotal, prometheus.CounterValue, float64(stats.TxN))
+	ch <- prometheus.MustNewConstMetric(c.openTx, prometheus.GaugeValue, float64(stats.OpenTxN))
+	ch <- prometheus.MustNewConstMetric(c.freePages, prometheus.GaugeValue, float64(stats.FreePageN))
+	ch <- prometheus.MustNewConstMetric(c.pendingPages, prometheus.GaugeValue, float64(stats.PendingPageN))
+	ch <- prometheus.MustNewConstMetric(c.freeAlloc, prometheus.GaugeValue, float64(stats.FreeAlloc))
+	ch <- prometheus.MustNewConstMetric(c.pageAllocs, prometheus.CounterValue, float64(stats.TxStats.PageCount))
+	ch <- prometheus.MustNewConstMetric(c.pageAllocSize, prometheus.CounterValue, float64(stats.TxStats.PageAlloc))
+	ch <- prometheus.MustNewConstMetric(c.writeTime, prometheus.CounterValue, stats.TxStats.WriteTime.Seconds())
+
+	counts, err := c.objectCounts()
+	if err != nil {
+		log.WithError(err).Warn("failed to collect object counts")
+		return
+	}
+	for _, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.objects, prometheus.GaugeValue, float64(n.databases), n.catalog, "database")
+		ch <- prometheus.MustNewConstMetric(c.objects, prometheus.GaugeValue, float64(n.tables), n.catalog, "table")
+		ch <- prometheus.MustNewConstMetric(c.objects, prometheus.GaugeValue, float64(n.partitions), n.catalog, "partition")
+	}
+}
+
+// objectCounts returns object counts of all catalogs, counted again only when the
+// cached counts are older than objectsTTL.
+func (c *boltCollector) objectCounts() ([]catalogObjects, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.counted.IsZero() && time.Since(c.counted) < c.objectsTTL {
+		return c.counts, nil
+	}
+	var counts []catalogObjects
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return forEachCatalog(tx, func(name []byte, catBucket *bolt.Bucket) error {
+			n := catalogObjects{catalog: string(name)}
+			n.databases, n.tables, n.partitions = countObjects(catBucket)
+			counts = append(counts, n)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.counts, c.counted = counts, time.Now()
+	return counts, nil
+}
+
+// countObjects returns number of databases, tables and partitions in the catalog bucket.
+func countObjects(catBucket *bolt.Bucket) (databases int, tables int, partitions int) {
+	if idMap := catBucket.Bucket([]byte(byIDHdr)); idMap != nil {
+		databases = idMap.Stats().KeyN
+	}
+	dbInfo := catBucket.Bucket([]byte(dbHdr))
+	if dbInfo == nil {
+		return
+	}
+	dbInfo.ForEach(func(dbID, v []byte) error {
+		dbBucket := dbInfo.Bucket(dbID)
+		if dbBucket == nil {
+			return nil
+		}
+		if tblIDs := dbBucket.Bucket([]byte(byIDHdr)); tblIDs != nil {
+			tables += tblIDs.Stats().KeyN
+		}
+		tblsBucket := dbBucket.Bucket([]byte(tblsHdr))
+		if tblsBucket == nil {
+			return nil
+		}
+		return tblsBucket.ForEach(func(tblID, v []byte) error {
+			if tBucket := tblsBucket.Bucket(tblID); tBucket != nil {
+				partitions += tBucket.Stats().KeyN
+			}
+			return nil
+		})
+	})
+	return
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+)
+
+func TestObjectCountsCache(t *testing.T) {
+	s, _ := newTestServer(t, 0)
+	c := newBoltCollector(s.db, time.Hour)
+	partitions := func() int {
+		t.Helper()
+		counts, err := c.objectCounts()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, n := range counts {
+			if n.catalog == testCatalog {
+				return n.partitions
+			}
+		}
+		t.Fatalf("no counts of catalog %s", testCatalog)
+		return 0
+	}
+	if n := partitions(); n != 1 {
+		t.Fatalf("%d partitions, want 1", n)
+	}
+	resp, err := s.AddPartition(context.Background(), &pb.AddPartitionRequest{
+		Catalog:   testCatalog,
+		DbId:      &pb.Id{Name: testDb},
+		TableId:   &pb.Id{Name: testTbl},
+		Partition: &pb.Partition{Values: []string{"2"}},
+	})
+	checkStatus(t, resp.GetStatus(), err)
+	if n := partitions(); n != 1 {
+		t.Errorf("%d cached partitions, want 1", n)
+	}
+	c.objectsTTL = 0
+	if n := partitions(); n != 2 {
+		t.Errorf("%d partitions after the cache expired, want 2", n)
+	}
+}
@@ -0,0 +1,417 @@
+// Layout versioning
+//
+// The version of the on-disk layout is stored in the META root bucket. Files created
+// before versioning have no META bucket and are treated as version 0.
+//
+// Every layout change adds a migration to the end of the migrations list and bumps
+// layoutVersion. At startup all migrations newer than the file version are applied in
+// a single transaction, so a failed upgrade leaves the file unchanged. The server
+// refuses to open files with a layout newer than it supports.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/akolb1/hmsv2api/gometastore/hivetype"
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	metaBucket = "__META__" // Root bucket with layout metadata, not a catalog
+	versionKey = "version"  // Layout version in META bucket
+)
+
+// migration upgrades layout from version-1 to version
+type migration struct {
+	version     int
+	description string
+	migrate     func(tx *bolt.Tx) error
+}
+
+// migrations must be ordered by version
+var migrations = []migration{
+	{1, "add layout metadata", func(tx *bolt.Tx) error { return nil }},
+	{2, "add catalog records", addCatalogRecords},
+	{3, "add object ID index", addIDIndexes},
+	{4, "store partitions under Hive names", renamePartitions},
+	{5, "store partitions under typed keys", rekeyPartitions},
+	{6, "compact partition storage descriptors", compactPartitions},
+	{7, "add table schema history", addSchemaHistory},
+	{8, "rekey partitions of dec and numeric keys", rekeyDecimalPartitions},
+	{9, "rekey partitions of float and double keys", rekeyFloatPartitions},
+}
+
+// layoutVersion is the version of the layout created by this binary
+var layoutVersion = migrations[len(migrations)-1].version
+
+// addCatalogRecords turns implicitly created catalog buckets into catalogs
+func addCatalogRecords(tx *bolt.Tx) error {
+	return forEachCatalog(tx, func(name []byte, catBucket *bolt.Bucket) error {
+		if catBucket.Get([]byte(catalogHdr)) != nil {
+			return nil
+		}
+		return initCatalog(catBucket, &pb.Catalog{Name: string(name)})
+	})
+}
+
+// forEachTable calls fn for every decodable table which has a partition bucket
+func forEachTable(tx *bolt.Tx,
+	fn func(catalog string, table *pb.Table, partBucket *bolt.Bucket) error) error {
+	return forEachCatalog(tx, func(name []byte, catBucket *bolt.Bucket) error {
+		dbBuckets := catBucket.Bucket([]byte(dbHdr))
+		if dbBuckets == nil {
+			return nil
+		}
+		return dbBuckets.ForEach(func(dbID, v []byte) error {
+			dbBucket := dbBuckets.Bucket(dbID)
+			if v != nil || dbBucket == nil {
+				return nil
+			}
+			byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+			tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
+			if byIDBucket == nil || tablesBucket == nil {
+				return nil
+			}
+			return byIDBucket.ForEach(func(tableID, data []byte) error {
+				partBucket := tablesBucket.Bucket(tableID)
+				var table pb.Table
+				if partBucket == nil || proto.Unmarshal(data, &table) != nil {
+					return nil
+				}
+				return fn(string(name), &table, partBucket)
+			})
+		})
+	})
+}
+
+// renamePartitions moves partitions to keys which are their Hive names
+func renamePartitions(tx *bolt.Tx) error {
+	return forEachTable(tx, renameTablePartitions)
+}
+
+// renameTablePartitions renames partitions of a single table
+func renameTablePartitions(catalog string, table *pb.Table, partBucket *bolt.Bucket) error {
+	type move struct {
+		oldKey, key string
+		data        []byte
+	}
+	var moves []move
+	partBucket.ForEach(func(k, v []byte) error {
+		var partition pb.Partition
+		if v == nil || proto.Unmarshal(v, &partition) != nil {
+			return nil
+		}
+		key, err := partitionName(table, partition.Values)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"catalog": catalog,
+				"table":   table.GetId().GetName(),
+				"key":     string(k),
+			}).Warn("can't rename partition: ", err)
+			return nil
+		}
+		if key != string(k) {
+			moves = append(moves, move{string(k), key, append([]byte(nil), v...)})
+		}
+		return nil
+	})
+	for _, m := range moves {
+		if err := partBucket.Delete([]byte(m.oldKey)); err != nil {
+			return err
+		}
+		if err := partBucket.Put([]byte(m.key), m.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rekeyPartitions moves partitions to the typed partitionKey of their normalized
+// values. Partitions which don't match table partition keys or which collide with
+// another partition are left in place for fsck.
+func rekeyPartitions(tx *bolt.Tx) error {
+	return rekeyPartitionsOf(tx, func(table *pb.Table) bool { return true })
+}
+
+// rekeyDecimalPartitions rekeys partitions of tables with decimal partition keys.
+// Before types were parsed, dec and numeric keys were keyed and stored as strings.
+func rekeyDecimalPartitions(tx *bolt.Tx) error {
+	return rekeyPartitionsOf(tx, hasPartitionKeyOf(hivetype.Decimal))
+}
+
+// rekeyFloatPartitions rekeys partitions of tables with float and double partition
+// keys, which were keyed as strings.
+func rekeyFloatPartitions(tx *bolt.Tx) error {
+	return rekeyPartitionsOf(tx, hasPartitionKeyOf(hivetype.Float, hivetype.Double))
+}
+
+// rekeyPartitionsOf rekeys partitions of tables matching the filter
+func rekeyPartitionsOf(tx *bolt.Tx, filter func(table *pb.Table) bool) error {
+	return forEachTable(tx, func(catalog string, table *pb.Table, partBucket *bolt.Bucket) error {
+		if !filter(table) {
+			return nil
+		}
+		var idx *bolt.Bucket
+		if catBucket := tx.Bucket([]byte(catalog)); catBucket != nil {
+			idx = catBucket.Bucket([]byte(idxHdr))
+		}
+		return rekeyTablePartitions(catalog, table, partBucket, idx)
+	})
+}
+
+// hasPartitionKeyOf returns a filter of tables with a partition key of the kinds
+func hasPartitionKeyOf(kinds ...hivetype.Kind) func(table *pb.Table) bool {
+	return func(table *pb.Table) bool {
+		for _, key := range table.PartitionKeys {
+			t := partitionKeyType(key.Type)
+			if t == nil {
+				continue
+			}
+			for _, kind := range kinds {
+				if t.Kind == kind {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// rekeyTablePartitions rekeys partitions of a single table. Partitions with
+// normalized values are reindexed in idx when it isn't nil.
+func rekeyTablePartitions(catalog string, table *pb.Table, partBucket *bolt.Bucket,
+	idx *bolt.Bucket) error {
+	type move struct {
+		oldKey, key, data []byte
+		partition         *pb.Partition
+		reindex           bool
+	}
+	logger := log.WithFields(log.Fields{
+		"catalog": catalog,
+		"table":   table.GetId().GetName(),
+	})
+	// Keys which stay occupied after the migration
+	occupied := make(map[string]bool)
+	var moves []*move
+	err := partBucket.ForEach(func(k, v []byte) error {
+		var partition pb.Partition
+		if v == nil || proto.Unmarshal(v, &partition) != nil {
+			occupied[string(k)] = true
+			return nil
+		}
+		values, err := normalizePartValues(table, partition.Values)
+		if err != nil {
+			logger.WithField("values", partition.Values).Warn("can't rekey partition: ", err)
+			occupied[string(k)] = true
+			return nil
+		}
+		key, err := partitionKey(table, values)
+		if err != nil {
+			logger.WithField("values", partition.Values).Warn("can't rekey partition: ", err)
+			occupied[string(k)] = true
+			return nil
+		}
+		m := &move{oldKey: copyBytes(k), key: key, data: copyBytes(v), partition: &partition}
+		if !equalValues(values, partition.Values) {
+			partition.Values = values
+			if m.data, err = proto.Marshal(&partition); err != nil {
+				return err
+			}
+			m.reindex = true
+		}
+		if bytes.Equal(key, k) {
+			occupied[string(k)] = true
+		}
+		moves = append(moves, m)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Rejecting a move keeps its old key occupied, which may reject other moves,
+	// so repeat until no more moves are rejected.
+	for rejected := true; rejected; {
+		rejected = false
+		targets := make(map[string]int)
+		for _, m := range moves {
+			if !bytes.Equal(m.key, m.oldKey) {
+				targets[string(m.key)]++
+			}
+		}
+		accepted := moves[:0]
+		for _, m := range moves {
+			if !bytes.Equal(m.key, m.oldKey) &&
+				(occupied[string(m.key)] || targets[string(m.key)] > 1) {
+				logger.WithField("values", m.partition.Values).
+					Warn("can't rekey partition: collides with another partition")
+				occupied[string(m.oldKey)] = true
+				rejected = true
+				continue
+			}
+			accepted = append(accepted, m)
+		}
+		moves = accepted
+	}
+	// Remove all old keys first, so that new keys can't replace records not moved yet
+	for _, m := range moves {
+		if err := partBucket.Delete(m.oldKey); err != nil {
+			return err
+		}
+	}
+	for _, m := range moves {
+		if err := partBucket.Put(m.key, m.data); err != nil {
+			return err
+		}
+		if m.reindex && idx != nil {
+			if err := indexPartition(idx, table.GetId().GetId(), m.partition); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// compactPartitions removes storage descriptor fields inherited from the table
+func compactPartitions(tx *bolt.Tx) error {
+	return forEachTable(tx, func(catalog string, table *pb.Table, partBucket *bolt.Bucket) error {
+		updates := make(map[string][]byte)
+		partBucket.ForEach(func(k, v []byte) error {
+			var partition pb.Partition
+			if v == nil || proto.Unmarshal(v, &partition) != nil {
+				return nil
+			}
+			compactSd(table.Sd, &partition)
+			if len(partition.InheritedSdFields) == 0 {
+				return nil
+			}
+			if data, err := proto.Marshal(&partition); err == nil {
+				updates[string(k)] = data
+			}
+			return nil
+		})
+		for k, data := range updates {
+			if err := partBucket.Put([]byte(k), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// getLayoutVersion returns layout version of the file.
+func getLayoutVersion(tx *bolt.Tx) (int, error) {
+	meta := tx.Bucket([]byte(metaBucket))
+	if meta == nil {
+		return 0, nil
+	}
+	value := meta.Get([]byte(versionKey))
+	if value == nil {
+		return 0, fmt.Errorf("corrupt %s bucket: missing %s", metaBucket, versionKey)
+	}
+	version, err := strconv.Atoi(string(value))
+	if err != nil {
+		return 0, fmt.Errorf("corrupt %s bucket: invalid %s %q", metaBucket, versionKey, value)
+	}
+	return version, nil
+}
+
+func setLayoutVersion(tx *bolt.Tx, version int) error {
+	meta, err := tx.CreateBucketIfNotExists([]byte(metaBucket))
+	if err != nil {
+		return err
+	}
+	return meta.Put([]byte(versionKey), []byte(strconv.Itoa(version)))
+}
+
+// isEmpty returns true for a newly created file
+func isEmpty(tx *bolt.Tx) bool {
+	k, _ := tx.Cursor().First()
+	return k == nil
+}
+
+// migrateDB upgrades layout to the current version.
+func migrateDB(db *bolt.DB) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		if isEmpty(tx) {
+			return setLayoutVersion(tx, layoutVersion)
+		}
+		version, err := getLayoutVersion(tx)
+		if err != nil {
+			return err
+		}
+		if version > layoutVersion {
+			return fmt.Errorf("database layout version %d is newer than supported version %d",
+				version, layoutVersion)
+		}
+		if version == layoutVersion {
+			return nil
+		}
+		for _, m := range migrations {
+			if m.version <= version {
+				continue
+			}
+			log.WithField("version", m.version).Info("migrating layout: ", m.description)
+			if err := m.migrate(tx); err != nil {
+				return fmt.Errorf("migration to version %d failed: %v", m.version, err)
+			}
+		}
+		return setLayoutVersion(tx, layoutVersion)
+	})
+}
+
+// checkLayout verifies that read-only database has the current layout version.
+func checkLayout(db *bolt.DB) error {
+	return db.View(func(tx *bolt.Tx) error {
+		if isEmpty(tx) {
+			return nil
+		}
+		version, err := getLayoutVersion(tx)
+		if err != nil {
+			return err
+		}
+		if version != layoutVersion {
+			return fmt.Errorf("database layout version %d differs from supported version %d, "+
+				"run migrate command first", version, layoutVersion)
+		}
+		return nil
+	})
+}
+
+// openMetastore opens the database and makes sure it has the current layout.
+// Writable database is migrated, read-only database must be already migrated.
+func openMetastore(readOnly bool) (*bolt.DB, error) {
+	db, err := openDB(readOnly)
+	if err != nil {
+		return nil, err
+	}
+	if readOnly {
+		err = checkLayout(db)
+	} else {
+		err = migrateDB(db)
+	}
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// migrateCommand upgrades layout of the database without starting the server.
+func migrateCommand(args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("usage: migrate")
+	}
+	db, err := openMetastore(false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	log.WithField("version", layoutVersion).Info("layout is up to date")
+	return nil
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+)
+
+// openTestDB returns a new database which is removed at the end of the test
+func openTestDB(t *testing.T) *bolt.DB {
+	t.Helper()
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "test.db"), 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func mustMarshal(t *testing.T, m proto.Message) []byte {
+	t.Helper()
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestRekeyTablePartitions(t *testing.T) {
+	table := testTable("hr", "int")
+	typedKey := func(value string) string {
+		key, err := partitionKey(table, []string{value})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(key)
+	}
+	part := func(id string, value string) *pb.Partition {
+		return &pb.Partition{Id: &pb.Id{Id: id}, Values: []string{value}}
+	}
+
+	tests := []struct {
+		name   string
+		before map[string]*pb.Partition // nil partition is stored as undecodable record
+		after  map[string]string        // key -> ID of the partition stored there
+		index  map[string][]string      // ID -> indexed values
+	}{
+		{
+			name:   "move",
+			before: map[string]*pb.Partition{"hr=8": part("p8", "8")},
+			after:  map[string]string{typedKey("8"): "p8"},
+		},
+		{
+			name:   "normalize and reindex",
+			before: map[string]*pb.Partition{"hr=09": part("p9", "09")},
+			after:  map[string]string{typedKey("9"): "p9"},
+			index:  map[string][]string{"p9": {"9"}},
+		},
+		{
+			name:   "already rekeyed",
+			before: map[string]*pb.Partition{typedKey("3"): part("p3", "3")},
+			after:  map[string]string{typedKey("3"): "p3"},
+		},
+		{
+			name: "collision between moved partitions",
+			before: map[string]*pb.Partition{
+				"hr=7":  part("p7", "7"),
+				"hr=07": part("p07", "07"),
+				"hr=8":  part("p8", "8"),
+			},
+			after: map[string]string{"hr=7": "p7", "hr=07": "p07", typedKey("8"): "p8"},
+		},
+		{
+			name: "collision with partition in place",
+			before: map[string]*pb.Partition{
+				typedKey("5"): part("p5", "5"),
+				"hr=05":       part("p05", "05"),
+			},
+			after: map[string]string{typedKey("5"): "p5", "hr=05": "p05"},
+		},
+		{
+			name: "invalid and undecodable partitions stay",
+			before: map[string]*pb.Partition{
+				"hr=x":    part("px", "x"),
+				"garbage": nil,
+				"hr=1":    part("p1", "1"),
+			},
+			after: map[string]string{"hr=x": "px", "garbage": "", typedKey("1"): "p1"},
+		},
+		{
+			// Partition misfiled under the key of 5 can't move to the key of 6, so
+			// the partition with value 5 can't move to its key either.
+			name: "collision with rejected move",
+			before: map[string]*pb.Partition{
+				typedKey("6"): part("p6", "6"),
+				typedKey("5"): part("p6s", "6"),
+				"hr=5":        part("p5", "5"),
+			},
+			after: map[string]string{typedKey("6"): "p6", typedKey("5"): "p6s", "hr=5": "p5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDB(t)
+			err := db.Update(func(tx *bolt.Tx) error {
+				partBucket, err := tx.CreateBucket([]byte("parts"))
+				if err != nil {
+					return err
+				}
+				idx, err := tx.CreateBucket([]byte(idxHdr))
+				if err != nil {
+					return err
+				}
+				for key, partition := range tt.before {
+					data := []byte("garbage")
+					if partition != nil {
+						data = mustMarshal(t, partition)
+					}
+					if err := partBucket.Put([]byte(key), data); err != nil {
+						return err
+					}
+				}
+				return rekeyTablePartitions("cat", table, partBucket, idx)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			db.View(func(tx *bolt.Tx) error {
+				partBucket := tx.Bucket([]byte("parts"))
+				if n := partBucket.Stats().KeyN; n != len(tt.after) {
+					t.Errorf("%d partitions after rekeying, want %d", n, len(tt.after))
+				}
+				for key, id := range tt.after {
+					data := partBucket.Get([]byte(key))
+					if data == nil {
+						t.Errorf("missing partition %s under %q", id, key)
+						continue
+					}
+					var partition pb.Partition
+					if proto.Unmarshal(data, &partition) != nil {
+						if id != "" {
+							t.Errorf("can't decode partition %s under %q", id, key)
+						}
+						continue
+					}
+					if got := partition.GetId().GetId(); got != id {
+						t.Errorf("partition %s under %q, want %s", got, key, id)
+					}
+				}
+				idx := tx.Bucket([]byte(idxHdr))
+				for id, values := range tt.index {
+					loc, err := getIDLocation(idx, id)
+					if err != nil {
+						t.Errorf("partition %s is not indexed: %v", id, err)
+						continue
+					}
+					if loc.TableID != table.Id.Id || !equalValues(loc.Values, values) {
+						t.Errorf("partition %s indexed as %+v, want values %q", id, loc, values)
+					}
+				}
+				return nil
+			})
+		})
+	}
+}
+
+func TestRekeyPartitionsOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyType string
+		value   string // stored value, keyed as a string
+		lookup  string // value which finds the partition after the migration
+		migrate func(tx *bolt.Tx) error
+	}{
+		{"numeric", "numeric(10,2)", "1.50", "01.5", rekeyDecimalPartitions},
+		{"dec", "DEC(4)", "-07", "-7", rekeyDecimalPartitions},
+		{"double", "double", "-1.5", "-1.50", rekeyFloatPartitions},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newServer(openTestDB(t), 0)
+			c := context.Background()
+			catResp, err := s.CreateCatalog(c, &pb.CreateCatalogRequest{Catalog: &pb.Catalog{Name: testCatalog}})
+			checkStatus(t, catResp.GetStatus(), err)
+			dbResp, err := s.CreateDabatase(c, &pb.CreateDatabaseRequest{
+				Catalog:  testCatalog,
+				Database: &pb.Database{Id: &pb.Id{Name: testDb}},
+			})
+			checkStatus(t, dbResp.GetStatus(), err)
+			tblResp, err := s.CreateTable(c, &pb.CreateTableRequest{
+				Catalog: testCatalog,
+				DbId:    &pb.Id{Name: testDb},
+				Table: &pb.Table{
+					Id:            &pb.Id{Name: testTbl},
+					PartitionKeys: testColumns("p", tt.keyType),
+				},
+			})
+			checkStatus(t, tblResp.GetStatus(), err)
+			oldKey, err := encodePartValues(testColumns("p", "string"), []string{tt.value})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.db.Update(func(tx *bolt.Tx) error {
+				dbBucket, err := getDatabaseBucket(tx, testCatalog, &pb.Id{Name: testDb})
+				if err != nil {
+					return err
+				}
+				partBucket, err := getTableBucket(dbBucket, testCatalog, testDb, testTbl, true)
+				if err != nil {
+					return err
+				}
+				partition := &pb.Partition{Id: &pb.Id{Id: "pid"}, Values: []string{tt.value}}
+				if err = partBucket.Put(oldKey, mustMarshal(t, partition)); err != nil {
+					return err
+				}
+				return tt.migrate(tx)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := s.GetPartition(c, &pb.GetPartitionRequest{
+				Catalog: testCatalog,
+				DbId:    &pb.Id{Name: testDb},
+				TableId: &pb.Id{Name: testTbl},
+				Values:  []string{tt.lookup},
+			})
+			checkStatus(t, resp.GetStatus(), err)
+			if resp.Partition.GetId().GetId() != "pid" {
+				t.Errorf("found partition %v, want pid", resp.Partition)
+			}
+		})
+	}
+}
+
+func TestRenameTablePartitions(t *testing.T) {
+	table := testTable("ds", "string", "hr", "int")
+	db := openTestDB(t)
+	err := db.Update(func(tx *bolt.Tx) error {
+		partBucket, err := tx.CreateBucket([]byte("parts"))
+		if err != nil {
+			return err
+		}
+		// Before version 4 partitions were stored under joined values
+		for key, values := range map[string][]string{
+			"2020-01-01/1": {"2020-01-01", "1"},
+			"a/b/2":        {"a/b", "2"},
+		} {
+			data := mustMarshal(t, &pb.Partition{Values: values})
+			if err := partBucket.Put([]byte(key), data); err != nil {
+				return err
+			}
+		}
+		return renameTablePartitions("cat", table, partBucket)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.View(func(tx *bolt.Tx) error {
+		partBucket := tx.Bucket([]byte("parts"))
+		for _, key := range []string{"ds=2020-01-01/hr=1", "ds=a%2Fb/hr=2"} {
+			if partBucket.Get([]byte(key)) == nil {
+				t.Errorf("missing partition %s", key)
+			}
+		}
+		if n := partBucket.Stats().KeyN; n != 2 {
+			t.Errorf("%d partitions after renaming, want 2", n)
+		}
+		return nil
+	})
+}
+
+func TestMigrateDB(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string // stored version, empty for a new file
+		wantErr bool
+	}{
+		{"new file", "", false},
+		{"current version", strconv.Itoa(layoutVersion), false},
+		{"old version", "2", false},
+		{"newer version", strconv.Itoa(layoutVersion + 1), true},
+		{"invalid version", "x", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDB(t)
+			if tt.version != "" {
+				err := db.Update(func(tx *bolt.Tx) error {
+					meta, err := tx.CreateBucket([]byte(metaBucket))
+					if err != nil {
+						return err
+					}
+					return meta.Put([]byte(versionKey), []byte(tt.version))
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := migrateDB(db)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			db.View(func(tx *bolt.Tx) error {
+				if version, err := getLayoutVersion(tx); err != nil || version != layoutVersion {
+					t.Errorf("layout version %d (%v), want %d", version, err, layoutVersion)
+				}
+				return nil
+			})
+		})
+	}
+}
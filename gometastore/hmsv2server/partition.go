@@ -28,7 +28,6 @@ package main
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"io"
@@ -38,11 +37,10 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
-// TODO: Figure out schema evolution for partitions
-
 func (s *metastoreServer) AddPartition(c context.Context,
 	req *pb.AddPartitionRequest) (*pb.AddPartitionResponse, error) {
-	log.Println("AddPartition:", req)
+	logger := requestLogger(c)
+	logger.Debug("AddPartition: ", req)
 	catalog := req.Catalog
 	if catalog == "" {
 		return nil, fmt.Errorf("missing catalog")
@@ -65,50 +63,67 @@ func (s *metastoreServer) AddPartition(c context.Context,
 	if partition == nil {
 		return nil, fmt.Errorf("missing partition data")
 	}
-
-	// Construct partition name from values
-	values := strings.Join(partition.GetValues(), "/")
-	if values == "" {
+	if len(partition.GetValues()) == 0 {
 		return nil, fmt.Errorf("missing partition values")
 	}
 
+	if partition.Id == nil {
+		partition.Id = new(pb.Id)
+	}
 	partition.Id.Id = getULID()
+	now := nowMillis()
+	principal := principalFromContext(c)
+	partition.CreateTime, partition.LastModifiedTime, partition.LastAccessTime = now, now, now
+	partition.CreatedBy, partition.ModifiedBy = principal, principal
 
-	err := s.db.Update(func(tx *bolt.Tx) error {
+	err := s.update(c, func(tx *bolt.Tx) error {
 		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
 		if err != nil {
 			return err
 		}
+		table, tableID, err := getTable(dbBucket, catalog, dbName, tableName)
+		if err != nil {
+			return err
+		}
 		tablesBucket, err := getTableBucket(dbBucket, catalog, dbName, tableName, true)
 		if err != nil {
 			return err
 		}
+		if partition.Values, err = normalizePartValues(table, partition.Values); err != nil {
+			return err
+		}
+		key, err := partitionKey(table, partition.Values)
+		if err != nil {
+			return err
+		}
 		// Do we have this partition?
-		if p := tablesBucket.Get([]byte(values)); p != nil {
-			return fmt.Errorf("partition %s already exists", values)
+		if p := tablesBucket.Get(key); p != nil {
+			name, _ := partitionName(table, partition.Values)
+			return fmt.Errorf("partition %s already exists", name)
 		}
 		partition.SeqId, _ = tablesBucket.NextSequence()
+		partition.SchemaVersion = table.SchemaVersion
+		compactSd(table.Sd, partition)
 		data, err := proto.Marshal(partition)
 		if err != nil {
 			return err
 		}
-		err = tablesBucket.Put([]byte(values), data)
+		err = tablesBucket.Put(key, data)
 		if err != nil {
 			return err
 		}
-		log.Println("added partition", partition)
-
-		return nil
+		idx, err := getCatalogIDIndex(tx, catalog)
+		if err != nil {
+			return err
+		}
+		return indexPartition(idx, string(tableID), partition)
 	})
 
 	if err != nil {
-		log.Println("failed to create partition:", err)
+		logger.WithError(err).Warn("failed to create partition")
 		return &pb.AddPartitionResponse{
 			Sequence: req.Sequence,
-			Status: &pb.RequestStatus{
-				Status: pb.RequestStatus_STATUS_ERROR,
-				Error:  err.Error(),
-			},
+			Status:   errorStatus(err),
 		}, nil
 	}
 
@@ -119,8 +134,8 @@ func (s *metastoreServer) AddPartition(c context.Context,
 }
 
 func (s *metastoreServer) AddManyPartitions(stream pb.Metastore_AddManyPartitionsServer) error {
+	logger := requestLogger(stream.Context())
 	// Read first request
-	log.Println("AddMnayPartitions")
 	req, err := stream.Recv()
 	if err != nil {
 		if err == io.EOF {
@@ -129,7 +144,7 @@ func (s *metastoreServer) AddManyPartitions(stream pb.Metastore_AddManyPartition
 		return err
 	}
 
-	log.Println("AddPartition:", req)
+	logger.Debug("AddManyPartitions: ", req)
 	catalog := req.Catalog
 	if catalog == "" {
 		return fmt.Errorf("missing catalog")
@@ -152,10 +167,7 @@ func (s *metastoreServer) AddManyPartitions(stream pb.Metastore_AddManyPartition
 	if partition == nil {
 		return fmt.Errorf("missing partition data")
 	}
-
-	// Construct partition name from values
-	values := strings.Join(partition.GetValues(), "/")
-	if values == "" {
+	if len(partition.GetValues()) == 0 {
 		return fmt.Errorf("missing partition values")
 	}
 	return nil
@@ -163,7 +175,8 @@ func (s *metastoreServer) AddManyPartitions(stream pb.Metastore_AddManyPartition
 
 func (s *metastoreServer) GetPartition(c context.Context,
 	req *pb.GetPartitionRequest) (*pb.GetPartitionResponse, error) {
-	log.Println("GetPartition:", req)
+	logger := requestLogger(c)
+	logger.Debug("GetPartition: ", req)
 	catalog := req.Catalog
 	if catalog == "" {
 		return nil, fmt.Errorf("missing catalog")
@@ -183,14 +196,13 @@ func (s *metastoreServer) GetPartition(c context.Context,
 		return nil, fmt.Errorf("missing table name")
 	}
 
-	// Construct partition name from values
-	values := strings.Join(req.GetValues(), "/")
-	if values == "" {
+	if len(req.GetValues()) == 0 && req.Name == "" {
 		return nil, fmt.Errorf("missing partition values")
 	}
 	var partition pb.Partition
+	var schema *pb.TableSchema
 
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.view(c, func(tx *bolt.Tx) error {
 		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
 		if err != nil {
 			return err
@@ -199,60 +211,55 @@ func (s *metastoreServer) GetPartition(c context.Context,
 		if err != nil {
 			return err
 		}
-
-		var table pb.Table
-
-		// Extract table
-		byNameBucket := dbBucket.Bucket([]byte(bynameHdr))
-		if byNameBucket == nil {
-			return fmt.Errorf("corrupt catalog %s/%s: no BYNAME info", catalog, dbName)
-		}
-		byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
-		if byIDBucket == nil {
-			return fmt.Errorf("corrupt catalog %s/%s: no BYID info", catalog, dbName)
-		}
-		tblIDBytes := byNameBucket.Get([]byte(tableName))
-		if tblIDBytes == nil {
-			return fmt.Errorf("table %s:%s.%s does not exist", catalog, dbName, tableName)
+		table, tableID, err := getTable(dbBucket, catalog, dbName, tableName)
+		if err != nil {
+			return err
 		}
-		tableData := byIDBucket.Get(tblIDBytes)
-		if tableData == nil {
-			return fmt.Errorf("catalog corrupted: table %s:%s.%s does not exist",
-				catalog, dbName, tableName)
+
+		var key []byte
+		name := req.Name
+		if len(req.GetValues()) != 0 {
+			key, err = lookupPartitionKey(table, req.GetValues())
+			name = strings.Join(req.GetValues(), "/")
+		} else {
+			key, err = partitionKeyFromName(table, req.Name)
 		}
-		if err = proto.Unmarshal(tableData, &table); err != nil {
-			return fmt.Errorf("catalog corruted: can't decode table data for %s.%s: %v",
-				dbName, tableName, err)
+		if err != nil {
+			return err
 		}
 
 		// Do we have this partition?
-		data := tablesBucket.Get([]byte(values))
+		data := tablesBucket.Get(key)
 		if data == nil {
-			return fmt.Errorf("no partition %s.%s/%s", dbName, tableName, values)
+			return notFoundError(fmt.Sprintf("no partition %s.%s/%s", dbName, tableName, name))
 		}
 		if err := proto.Unmarshal(data, &partition); err != nil {
 			return err
 		}
-		partition.Table = &table
-		return nil
+		expandSd(table.Sd, &partition)
+		partition.Table = table
+		if req.EffectiveSchema {
+			schema, err = getTableSchema(dbBucket, tableID, partitionSchemaVersion(&partition))
+		}
+		return err
 	})
 
 	if err != nil {
-		log.Println("failed to get partition:", err)
-		return &pb.GetPartitionResponse{
-			Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_ERROR, Error: err.Error()},
-		}, nil
+		logger.WithError(err).Warn("failed to get partition")
+		return &pb.GetPartitionResponse{Status: errorStatus(err)}, nil
 	}
 
 	return &pb.GetPartitionResponse{
 		Status:    &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK},
 		Partition: &partition,
+		Schema:    schema,
 	}, nil
 }
 
 func (s *metastoreServer) ListPartitions(req *pb.ListPartitionsRequest,
 	stream pb.Metastore_ListPartitionsServer) error {
-	log.Println("ListPartitions:", req)
+	logger := requestLogger(stream.Context())
+	logger.Debug("ListPartitions: ", req)
 	catalog := req.Catalog
 	if catalog == "" {
 		return fmt.Errorf("missing catalog")
@@ -272,17 +279,7 @@ func (s *metastoreServer) ListPartitions(req *pb.ListPartitionsRequest,
 		return fmt.Errorf("missing table name")
 	}
 
-	// Values that we are interested in
-	valuesMap := make(map[string]bool)
-
-	if req.Values != nil {
-		for _, values := range req.Values {
-			vString := strings.Join(values.GetValue(), "/")
-			valuesMap[vString] = true
-		}
-	}
-
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.view(stream.Context(), func(tx *bolt.Tx) error {
 		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
 		if err != nil {
 			return err
@@ -292,30 +289,19 @@ func (s *metastoreServer) ListPartitions(req *pb.ListPartitionsRequest,
 			return err
 		}
 
-		// Extract table
-		byNameBucket := dbBucket.Bucket([]byte(bynameHdr))
-		if byNameBucket == nil {
-			return fmt.Errorf("corrupt catalog %s/%s: no BYNAME info", catalog, dbName)
-		}
-		byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
-		if byIDBucket == nil {
-			return fmt.Errorf("corrupt catalog %s/%s: no BYID info", catalog, dbName)
-		}
-		tblIDBytes := byNameBucket.Get([]byte(tableName))
-		if tblIDBytes == nil {
-			return fmt.Errorf("table %s:%s.%s does not exist", catalog, dbName, tableName)
-		}
-		data := byIDBucket.Get(tblIDBytes)
-		if data == nil {
-			return fmt.Errorf("catalog corrupted: table %s:%s.%s does not exist",
-				catalog, dbName, tableName)
+		table, _, err := getTable(dbBucket, catalog, dbName, tableName)
+		if err != nil {
+			return err
 		}
 
-		var table pb.Table
-		err = proto.Unmarshal(data, &table)
+		// Partitions that we are interested in
+		keys, err := requestedPartitionKeys(table, req.Values, req.Names)
 		if err != nil {
-			return fmt.Errorf("catalog corruted: can't decode table data for %s.%s: %v",
-				dbName, tableName, err)
+			return err
+		}
+		partRange, err := newPartitionRange(table, req.From, req.To, req.Prefix)
+		if err != nil {
+			return err
 		}
 
 		first := true
@@ -325,6 +311,12 @@ func (s *metastoreServer) ListPartitions(req *pb.ListPartitionsRequest,
 			if err := proto.Unmarshal(v, partition); err != nil {
 				return nil
 			}
+			if partition.LastModifiedTime < req.ModifiedSince {
+				return nil
+			}
+			if !req.Compact {
+				expandSd(table.Sd, partition)
+			}
 			if len(req.GetFields()) != 0 {
 				// Only include specified fields
 				part := &pb.Partition{}
@@ -340,77 +332,87 @@ func (s *metastoreServer) ListPartitions(req *pb.ListPartitionsRequest,
 						part.Sd = partition.Sd
 					case "sd.parameters":
 						if part.Sd == nil {
-							part.Sd = &pb.StorageDescriptor{Parameters: partition.Sd.Parameters}
-							log.Println("sd = ", part.Sd, partition.Sd.Parameters)
+							part.Sd = &pb.StorageDescriptor{Parameters: partition.GetSd().GetParameters()}
 						} else {
-							part.Sd.Parameters = partition.Sd.Parameters
+							part.Sd.Parameters = partition.GetSd().GetParameters()
 						}
 					case "sd.serdeinfo":
 						if part.Sd == nil {
-							part.Sd = &pb.StorageDescriptor{SerdeInfo: partition.Sd.SerdeInfo}
+							part.Sd = &pb.StorageDescriptor{SerdeInfo: partition.GetSd().GetSerdeInfo()}
 						} else {
-							part.Sd.SerdeInfo = partition.Sd.SerdeInfo
+							part.Sd.SerdeInfo = partition.GetSd().GetSerdeInfo()
 						}
 					case "sd.serdeinfo.parameters":
 						if part.Sd == nil {
-							part.Sd = &pb.StorageDescriptor{SerdeInfo: &pb.SerDeInfo{Parameters: partition.Sd.SerdeInfo.Parameters}}
+							part.Sd = &pb.StorageDescriptor{SerdeInfo: &pb.SerDeInfo{Parameters: partition.GetSd().GetSerdeInfo().GetParameters()}}
+						} else if part.Sd.SerdeInfo == nil {
+							part.Sd.SerdeInfo = &pb.SerDeInfo{Parameters: partition.GetSd().GetSerdeInfo().GetParameters()}
 						} else {
-							part.Sd.SerdeInfo.Parameters = partition.Sd.SerdeInfo.Parameters
+							part.Sd.SerdeInfo.Parameters = partition.GetSd().GetSerdeInfo().GetParameters()
 						}
 					case "table":
 						if first {
 							// Include table in first partition only
 							first = false
-							part.Table = &table
+							part.Table = table
 						}
 					}
 				}
+				if req.Compact {
+					if part.Sd != nil {
+						part.InheritedSdFields = partition.InheritedSdFields
+					}
+					if first {
+						// Compact partitions are expanded using the table Sd
+						first = false
+						part.Table = table
+					}
+				}
 
 				if req.GetExclude() != nil {
-				    excludeParts(part, req.GetExclude())
-                }
-				log.Println("send", part)
+					excludeParts(part, req.GetExclude())
+				}
 				if err := stream.Send(part); err != nil {
-					log.Println("err sending:", err)
+					logger.WithError(err).Warn("failed to send partition")
 					return err
 				}
 			} else {
 				if first {
 					// Include table in first partition only
 					first = false
-					partition.Table = &table
+					partition.Table = table
+				}
+				if req.GetExclude() != nil {
+					excludeParts(partition, req.GetExclude())
 				}
-				log.Println("Send partition", partition)
-                if req.GetExclude() != nil {
-                    excludeParts(partition, req.GetExclude())
-                }
 				if err := stream.Send(partition); err != nil {
-					log.Println("err sending:", err)
+					logger.WithError(err).Warn("failed to send partition")
 					return err
 				}
 			}
 			return nil
 		}
 
-		if len(valuesMap) == 0 {
-			tablesBucket.ForEach(walker)
-		} else {
-			// Walk over values only
-			for value := range valuesMap {
-				v := tablesBucket.Get([]byte(value))
-				if v == nil {
-					continue
+		if len(keys) == 0 {
+			return partRange.scan(tablesBucket, req.Descending, walker)
+		}
+		// Walk over requested partitions only
+		for _, key := range sortPartitionKeys(keys, req.Descending) {
+			if !partRange.contains(key) {
+				continue
+			}
+			if v := tablesBucket.Get(key); v != nil {
+				if err := walker(key, v); err != nil {
+					return err
 				}
-				walker([]byte(value), v)
 			}
 		}
-
 		return nil
 	})
 
 	if err != nil {
-		log.Println("failed to list partitions:", err)
-		return err
+		logger.WithError(err).Warn("failed to list partitions")
+		return streamError(err)
 	}
 
 	return nil
@@ -418,7 +420,8 @@ func (s *metastoreServer) ListPartitions(req *pb.ListPartitionsRequest,
 
 func (s *metastoreServer) DropPartitions(c context.Context,
 	req *pb.DropPartitionsRequest) (*pb.RequestStatus, error) {
-	log.Println("DropPartition:", req)
+	logger := requestLogger(c)
+	logger.Debug("DropPartitions: ", req)
 	catalog := req.Catalog
 	if catalog == "" {
 		return nil, fmt.Errorf("missing catalog")
@@ -437,9 +440,7 @@ func (s *metastoreServer) DropPartitions(c context.Context,
 	if tableName == "" {
 		return nil, fmt.Errorf("missing table name")
 	}
-	partitionValues := req.GetValues()
-
-	err := s.db.Update(func(tx *bolt.Tx) error {
+	err := s.update(c, func(tx *bolt.Tx) error {
 		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
 		if err != nil {
 			return err
@@ -448,9 +449,39 @@ func (s *metastoreServer) DropPartitions(c context.Context,
 		if err != nil {
 			return err
 		}
-		for _, values := range partitionValues {
-			vString := strings.Join(values.GetValue(), "/")
-			if err = tablesBucket.Delete([]byte(vString)); err != nil {
+		table, _, err := getTable(dbBucket, catalog, dbName, tableName)
+		if err != nil {
+			return err
+		}
+		keys, err := requestedPartitionKeys(table, req.GetValues(), req.GetNames())
+		if err != nil {
+			return err
+		}
+		if len(req.GetPrefix()) != 0 {
+			partRange, err := newPartitionRange(table, nil, nil, req.GetPrefix())
+			if err != nil {
+				return err
+			}
+			// Collect keys first, the bucket can't be modified during the scan
+			err = partRange.scan(tablesBucket, false, func(k, v []byte) error {
+				keys = append(keys, copyBytes(k))
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		idx, err := getCatalogIDIndex(tx, catalog)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if data := tablesBucket.Get(key); data != nil {
+				if err = unindexPartition(idx, data); err != nil {
+					return err
+				}
+			}
+			if err = tablesBucket.Delete(key); err != nil {
 				return err
 			}
 		}
@@ -458,13 +489,108 @@ func (s *metastoreServer) DropPartitions(c context.Context,
 	})
 
 	if err != nil {
-		log.Println("failed to delete table:", err)
+		logger.WithError(err).Warn("failed to delete partitions")
 		return nil, err
 	}
 
 	return &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK}, nil
 }
 
+func (s *metastoreServer) GetPartitionNames(c context.Context,
+	req *pb.GetPartitionNamesRequest) (*pb.GetPartitionNamesResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("GetPartitionNames: ", req)
+	catalog := req.Catalog
+	if catalog == "" {
+		return nil, fmt.Errorf("missing catalog")
+	}
+	if req.DbId == nil {
+		return nil, fmt.Errorf("missing Db info")
+	}
+	dbName := req.DbId.Name
+	if dbName == "" {
+		return nil, fmt.Errorf("missing database name")
+	}
+	if req.TableId == nil {
+		return nil, fmt.Errorf("missing table info")
+	}
+	tableName := req.TableId.Name
+	if tableName == "" {
+		return nil, fmt.Errorf("missing table name")
+	}
+
+	var names []string
+	err := s.view(c, func(tx *bolt.Tx) error {
+		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
+		if err != nil {
+			return err
+		}
+		tablesBucket, err := getTableBucket(dbBucket, catalog, dbName, tableName, false)
+		if err != nil {
+			return err
+		}
+		table, _, err := getTable(dbBucket, catalog, dbName, tableName)
+		if err != nil {
+			return err
+		}
+		cursor := tablesBucket.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			if req.MaxParts > 0 && len(names) == int(req.MaxParts) {
+				break
+			}
+			var partition pb.Partition
+			if v == nil || proto.Unmarshal(v, &partition) != nil {
+				continue
+			}
+			name, err := partitionName(table, partition.Values)
+			if err != nil {
+				return err
+			}
+			names = append(names, name)
+		}
+		return nil
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to get partition names")
+		return &pb.GetPartitionNamesResponse{Status: errorStatus(err)}, nil
+	}
+
+	return &pb.GetPartitionNamesResponse{
+		Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK},
+		Names:  names,
+	}, nil
+}
+
+// getTable returns table record and table ID
+func getTable(dbBucket *bolt.Bucket, catalog string, dbName string,
+	tableName string) (*pb.Table, []byte, error) {
+	byNameBucket := dbBucket.Bucket([]byte(bynameHdr))
+	if byNameBucket == nil {
+		return nil, nil, fmt.Errorf("corrupt catalog %s/%s: no BYNAME info", catalog, dbName)
+	}
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	if byIDBucket == nil {
+		return nil, nil, fmt.Errorf("corrupt catalog %s/%s: no BYID info", catalog, dbName)
+	}
+	tblIDBytes := byNameBucket.Get([]byte(tableName))
+	if tblIDBytes == nil {
+		return nil, nil, notFoundError(fmt.Sprintf("table %s:%s.%s does not exist",
+			catalog, dbName, tableName))
+	}
+	data := byIDBucket.Get(tblIDBytes)
+	if data == nil {
+		return nil, nil, fmt.Errorf("catalog corrupted: table %s:%s.%s does not exist",
+			catalog, dbName, tableName)
+	}
+	var table pb.Table
+	if err := proto.Unmarshal(data, &table); err != nil {
+		return nil, nil, fmt.Errorf("catalog corruted: can't decode table data for %s.%s: %v",
+			dbName, tableName, err)
+	}
+	return &table, tblIDBytes, nil
+}
+
 func getTableBucket(dbBucket *bolt.Bucket, catalog string, dbName string, tableName string,
 	create bool) (*bolt.Bucket, error) {
 	byNameBucket := dbBucket.Bucket([]byte(bynameHdr))
@@ -473,7 +599,7 @@ func getTableBucket(dbBucket *bolt.Bucket, catalog string, dbName string, tableN
 	}
 	tblIDBytes := byNameBucket.Get([]byte(tableName))
 	if tblIDBytes == nil {
-		return nil, fmt.Errorf("table %s:%s.%s does not exist", catalog, dbName, tableName)
+		return nil, notFoundError(fmt.Sprintf("table %s:%s.%s does not exist", catalog, dbName, tableName))
 	}
 	tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
 	if tablesBucket == nil {
@@ -504,6 +630,7 @@ func excludeParts(part *pb.Partition, exclude []string) {
 			part.Values = nil
 		case "sd":
 			part.Sd = nil
+			part.InheritedSdFields = nil
 		case "sd.parameters":
 			if part.Sd != nil {
 				part.Sd.Parameters = nil
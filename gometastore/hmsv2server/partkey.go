@@ -0,0 +1,335 @@
+// Partition keys
+//
+// Partitions are stored in the table bucket under keys which sort like partition
+// values of their types, so cursors return partitions ordered by values (hr=9 before
+// hr=10) and ranges of partitions are found with Seek. Every value is encoded as a tag
+// byte, 0 for NULL (empty value) and 1 otherwise, followed by
+//
+//   - integer types, date, timestamp: big-endian integers with the sign bit flipped
+//     (days or seconds and nanoseconds since epoch for date and timestamp)
+//   - decimal: unscaled value with the type scale as a 128-bit integer with the
+//     sign bit flipped
+//   - float, double: IEEE 754 bits of the double value with the sign bit flipped for
+//     positive values and all bits flipped for negative ones
+//   - other types: value bytes with 0x00 escaped as 0x00 0xFF, followed by 0x00 0x01
+//
+// Encoded values are self-delimiting, so the key of the leading values is a prefix of
+// keys of all partitions having these values. Keys are built from normalized values.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/akolb1/hmsv2api/gometastore/hivetype"
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+)
+
+const (
+	nullTag  = 0x00
+	valueTag = 0x01
+)
+
+// decimalOffset is added to unscaled decimal values to make them non-negative
+var decimalOffset = new(big.Int).Lsh(big.NewInt(1), 127)
+
+func appendInt64(buf []byte, v int64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(v)^(1<<63))
+	return append(buf, b[:]...)
+}
+
+// appendFloat64 appends v so that keys of smaller values sort first. Negative zero
+// has the key of zero.
+func appendFloat64(buf []byte, v float64) []byte {
+	if v == 0 {
+		v = 0
+	}
+	bits := math.Float64bits(v)
+	if bits&(1<<63) != 0 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], bits)
+	return append(buf, b[:]...)
+}
+
+// appendPartValue appends encoded normalized value of the Hive type to buf
+func appendPartValue(buf []byte, typ string, value string) ([]byte, error) {
+	if value == "" || value == defaultPartitionName {
+		return append(buf, nullTag), nil
+	}
+	buf = append(buf, valueTag)
+	t := partitionKeyType(typ)
+	if t == nil {
+		t = &hivetype.Type{Kind: hivetype.String}
+	}
+	if bits, ok := intBits[t.Kind]; ok {
+		v, err := strconv.ParseInt(value, 10, bits)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", value)
+		}
+		return appendInt64(buf, v), nil
+	}
+	switch t.Kind {
+	case hivetype.Float, hivetype.Double:
+		v, err := strconv.ParseFloat(value, floatBits[t.Kind])
+		if err != nil {
+			return nil, fmt.Errorf("invalid floating point number %q", value)
+		}
+		return appendFloat64(buf, v), nil
+	case hivetype.Date:
+		t, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q", value)
+		}
+		return appendInt64(buf, t.Unix()/(24*60*60)), nil
+	case hivetype.Timestamp:
+		t, err := time.Parse("2006-01-02 15:04:05", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp %q", value)
+		}
+		buf = appendInt64(buf, t.Unix())
+		var b [4]byte
+		binary.BigEndian.PutUint32(b[:], uint32(t.Nanosecond()))
+		return append(buf, b[:]...), nil
+	case hivetype.Decimal:
+		v, err := unscaledDecimal(value, t.Scale)
+		if err != nil {
+			return nil, err
+		}
+		var b [16]byte
+		return append(buf, v.Add(v, decimalOffset).FillBytes(b[:])...), nil
+	}
+	for i := 0; i < len(value); i++ {
+		if value[i] == 0 {
+			buf = append(buf, 0x00, 0xFF)
+		} else {
+			buf = append(buf, value[i])
+		}
+	}
+	return append(buf, 0x00, 0x01), nil
+}
+
+// unscaledDecimal returns decimal value multiplied by 10^scale
+func unscaledDecimal(value string, scale int) (*big.Int, error) {
+	sign, intPart, fraction, err := splitDecimal(value)
+	if err != nil || len(fraction) > scale {
+		return nil, fmt.Errorf("invalid decimal %q", value)
+	}
+	digits := intPart + fraction + strings.Repeat("0", scale-len(fraction))
+	v, ok := new(big.Int).SetString(sign+"0"+digits, 10)
+	if !ok || v.CmpAbs(decimalOffset) >= 0 {
+		return nil, fmt.Errorf("invalid decimal %q", value)
+	}
+	return v, nil
+}
+
+// encodePartValues returns the key of the normalized values of the leading partition
+// keys.
+func encodePartValues(keys []*pb.FieldSchema, values []string) ([]byte, error) {
+	if len(values) > len(keys) {
+		return nil, invalidError(fmt.Sprintf("expected at most %d partition values, got %d",
+			len(keys), len(values)))
+	}
+	var buf []byte
+	for i, value := range values {
+		var err error
+		if buf, err = appendPartValue(buf, keys[i].Type, value); err != nil {
+			return nil, invalidError(fmt.Sprintf("partition key %s: %v", keys[i].Name, err))
+		}
+	}
+	return buf, nil
+}
+
+// partitionKey returns the Bolt key of the partition of the table with the
+// stored values.
+func partitionKey(table *pb.Table, values []string) ([]byte, error) {
+	if len(values) == 0 {
+		return nil, invalidError("missing partition values")
+	}
+	if len(values) != len(table.PartitionKeys) {
+		return nil, invalidError(fmt.Sprintf("expected %d partition values, got %d",
+			len(table.PartitionKeys), len(values)))
+	}
+	return encodePartValues(table.PartitionKeys, values)
+}
+
+// lookupPartitionKey returns the Bolt key of the partition with values sent by
+// client, which are normalized first.
+func lookupPartitionKey(table *pb.Table, values []string) ([]byte, error) {
+	if len(values) == 0 {
+		return nil, invalidError("missing partition values")
+	}
+	values, err := normalizePartValues(table, values)
+	if err != nil {
+		return nil, err
+	}
+	return partitionKey(table, values)
+}
+
+// partitionKeyFromName returns the Bolt key of the partition specified by name.
+func partitionKeyFromName(table *pb.Table, name string) ([]byte, error) {
+	values, err := parsePartName(table.PartitionKeys, name)
+	if err != nil {
+		return nil, err
+	}
+	return lookupPartitionKey(table, values)
+}
+
+// requestedPartitionKeys returns Bolt keys of partitions specified either by values
+// or by names.
+func requestedPartitionKeys(table *pb.Table, values []*pb.PartitionValues,
+	names []string) ([][]byte, error) {
+	keys := make([][]byte, 0, len(values)+len(names))
+	for _, v := range values {
+		key, err := lookupPartitionKey(table, v.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	for _, name := range names {
+		key, err := partitionKeyFromName(table, name)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// partitionRange is a range of partition keys, lower bound is inclusive and upper
+// bound is exclusive. Nil bounds are unlimited.
+type partitionRange struct {
+	lower []byte
+	upper []byte
+}
+
+// leadingKey returns the key of normalized values of the leading partition keys
+func leadingKey(table *pb.Table, values []string) ([]byte, error) {
+	values, err := normalizeLeadingValues(table.PartitionKeys, values)
+	if err != nil {
+		return nil, err
+	}
+	return encodePartValues(table.PartitionKeys, values)
+}
+
+// prefixEnd returns the smallest key which is greater than all keys with the prefix
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] != 0xFF {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// newPartitionRange returns range of partitions at or after from values, before
+// to values and having prefix values. Every list has values of leading partition keys
+// and may be empty.
+func newPartitionRange(table *pb.Table, from, to, prefix []string) (*partitionRange, error) {
+	r := new(partitionRange)
+	if len(prefix) != 0 {
+		key, err := leadingKey(table, prefix)
+		if err != nil {
+			return nil, err
+		}
+		r.lower, r.upper = key, prefixEnd(key)
+	}
+	if len(from) != 0 {
+		key, err := leadingKey(table, from)
+		if err != nil {
+			return nil, err
+		}
+		if r.lower == nil || bytes.Compare(key, r.lower) > 0 {
+			r.lower = key
+		}
+	}
+	if len(to) != 0 {
+		key, err := leadingKey(table, to)
+		if err != nil {
+			return nil, err
+		}
+		if r.upper == nil || bytes.Compare(key, r.upper) < 0 {
+			r.upper = key
+		}
+	}
+	return r, nil
+}
+
+// contains returns true if the key is within the range
+func (r *partitionRange) contains(key []byte) bool {
+	return (r.lower == nil || bytes.Compare(key, r.lower) >= 0) &&
+		(r.upper == nil || bytes.Compare(key, r.upper) < 0)
+}
+
+// scan calls fn for every partition within the range in key order
+func (r *partitionRange) scan(partBucket *bolt.Bucket, descending bool,
+	fn func(k, v []byte) error) error {
+	cursor := partBucket.Cursor()
+	var k, v []byte
+	if !descending {
+		if r.lower == nil {
+			k, v = cursor.First()
+		} else {
+			k, v = cursor.Seek(r.lower)
+		}
+		for ; k != nil && r.contains(k); k, v = cursor.Next() {
+			if v == nil {
+				continue
+			}
+			if err := fn(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if r.upper == nil {
+		k, v = cursor.Last()
+	} else if k, v = cursor.Seek(r.upper); k == nil {
+		k, v = cursor.Last()
+	} else {
+		k, v = cursor.Prev()
+	}
+	for ; k != nil && r.contains(k); k, v = cursor.Prev() {
+		if v == nil {
+			continue
+		}
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortPartitionKeys sorts keys in the order of the scan and removes duplicates
+func sortPartitionKeys(keys [][]byte, descending bool) [][]byte {
+	sort.Slice(keys, func(i, j int) bool {
+		if descending {
+			return bytes.Compare(keys[i], keys[j]) > 0
+		}
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	result := keys[:0]
+	for _, key := range keys {
+		if len(result) == 0 || !bytes.Equal(key, result[len(result)-1]) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
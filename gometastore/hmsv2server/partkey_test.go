@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+)
+
+// testTable returns a table with partition keys of the given names and types
+func testTable(keys ...string) *pb.Table {
+	table := &pb.Table{Id: &pb.Id{Name: "t", Id: "tid"}}
+	for i := 0; i < len(keys); i += 2 {
+		table.PartitionKeys = append(table.PartitionKeys,
+			&pb.FieldSchema{Name: keys[i], Type: keys[i+1]})
+	}
+	return table
+}
+
+func TestPartitionKeyOrder(t *testing.T) {
+	tests := []struct {
+		typ    string
+		values []string // in ascending order
+	}{
+		{"int", []string{"", "-100", "-1", "0", "9", "10", "100"}},
+		{"tinyint", []string{"-128", "-1", "0", "127"}},
+		{"bigint", []string{"-9223372036854775808", "-1", "0", "9223372036854775807"}},
+		{"date", []string{"", "1969-12-31", "1970-01-01", "2020-01-09", "2020-01-10", "2020-10-01"}},
+		{"timestamp", []string{"1969-12-31 23:59:59.5", "1970-01-01 00:00:00",
+			"2020-01-01 09:00:00", "2020-01-01 09:00:00.000000001", "2020-01-01 10:00:00"}},
+		{"decimal(10,2)", []string{"", "-10.5", "-1", "-0.01", "0", "0.01", "2", "10.5"}},
+		{"double", []string{"", "-Inf", "-1e300", "-10", "-1.5", "-1e-300", "0", "1e-300",
+			"0.5", "2", "10", "1e300", "+Inf"}},
+		{"float", []string{"-3.4e38", "-1", "-0.25", "0", "0.25", "1.5", "3.4e38"}},
+		{"string", []string{"", "a", "a\x00", "a\x00b", "ab", "b"}},
+		{"varchar(10)", []string{"10", "9", "a"}}, // lexicographic
+	}
+	for _, tt := range tests {
+		table := testTable("p", tt.typ)
+		var prev []byte
+		for i, value := range tt.values {
+			key, err := lookupPartitionKey(table, []string{value})
+			if err != nil {
+				t.Fatalf("%s: key of %q: %v", tt.typ, value, err)
+			}
+			if i > 0 && bytes.Compare(prev, key) >= 0 {
+				t.Errorf("%s: key of %q doesn't sort after %q", tt.typ, value, tt.values[i-1])
+			}
+			prev = key
+		}
+	}
+}
+
+func TestPartitionKeyMultipleKeys(t *testing.T) {
+	table := testTable("ds", "date", "hr", "int")
+	ordered := [][]string{
+		{"2020-01-01", "9"},
+		{"2020-01-01", "10"},
+		{"2020-01-02", "1"},
+		{"2020-01-10", "0"},
+	}
+	keys := make([][]byte, len(ordered))
+	for i, values := range ordered {
+		key, err := lookupPartitionKey(table, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i > 0 && bytes.Compare(keys[i-1], key) >= 0 {
+			t.Errorf("key of %q doesn't sort after %q", values, ordered[i-1])
+		}
+		keys[i] = key
+	}
+
+	// Key of leading values is a prefix of keys of all matching partitions
+	r, err := newPartitionRange(table, nil, nil, []string{"2020-01-01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, key := range keys {
+		if want := i < 2; r.contains(key) != want {
+			t.Errorf("prefix range contains %q = %v, want %v", ordered[i], !want, want)
+		}
+	}
+	r, err = newPartitionRange(table, []string{"2020-01-01", "10"}, []string{"2020-01-10"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, key := range keys {
+		if want := i == 1 || i == 2; r.contains(key) != want {
+			t.Errorf("range contains %q = %v, want %v", ordered[i], !want, want)
+		}
+	}
+}
+
+func TestPartitionKeyRoundTrip(t *testing.T) {
+	tests := []struct {
+		keys       []string
+		values     []string
+		normalized []string
+	}{
+		{[]string{"hr", "int"}, []string{"007"}, []string{"7"}},
+		{[]string{"hr", "int"}, []string{"+7"}, []string{"7"}},
+		{[]string{"d", "decimal(10,2)"}, []string{"-0.50"}, []string{"-0.5"}},
+		{[]string{"d", "decimal(10,2)"}, []string{"-0.00"}, []string{"0"}},
+		{[]string{"ts", "timestamp"}, []string{"2020-01-01 10:00:00.100"},
+			[]string{"2020-01-01 10:00:00.1"}},
+		{[]string{"ds", "date", "name", "string"}, []string{"2020-01-01", "a/b=c%"},
+			[]string{"2020-01-01", "a/b=c%"}},
+		{[]string{"ds", "string"}, []string{defaultPartitionName}, []string{defaultPartitionName}},
+		{[]string{"f", "double"}, []string{"1.50"}, []string{"1.50"}},
+	}
+	for _, tt := range tests {
+		table := testTable(tt.keys...)
+		values, err := normalizePartValues(table, tt.values)
+		if err != nil {
+			t.Fatalf("normalize %q: %v", tt.values, err)
+		}
+		if !equalValues(values, tt.normalized) {
+			t.Errorf("normalize %q = %q, want %q", tt.values, values, tt.normalized)
+		}
+		key, err := lookupPartitionKey(table, tt.values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stored, err := partitionKey(table, tt.normalized)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(key, stored) {
+			t.Errorf("%q and %q have different keys", tt.values, tt.normalized)
+		}
+		name, err := partitionName(table, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := parsePartName(table.PartitionKeys, name)
+		if err != nil {
+			t.Fatalf("parse %s: %v", name, err)
+		}
+		if !equalValues(parsed, values) {
+			t.Errorf("name %s parsed as %q, want %q", name, parsed, values)
+		}
+		if key, err = partitionKeyFromName(table, name); err != nil || !bytes.Equal(key, stored) {
+			t.Errorf("name %s has different key: %v", name, err)
+		}
+	}
+}
+
+func TestPartitionKeyErrors(t *testing.T) {
+	tests := []struct {
+		keys   []string
+		values []string
+	}{
+		{[]string{"hr", "int"}, nil},
+		{[]string{"hr", "int"}, []string{"1", "2"}},
+		{[]string{"hr", "int"}, []string{"x"}},
+		{[]string{"hr", "tinyint"}, []string{"128"}},
+		{[]string{"d", "decimal(4,2)"}, []string{"123.4"}},
+		{[]string{"d", "decimal(4,2)"}, []string{"1.234"}},
+		{[]string{"ds", "date"}, []string{"2020-13-01"}},
+		{[]string{"c", "char(2)"}, []string{"abc"}},
+		{[]string{"f", "double"}, []string{"1.5x"}},
+		{[]string{"f", "float"}, []string{"1e39"}},
+	}
+	for _, tt := range tests {
+		if _, err := lookupPartitionKey(testTable(tt.keys...), tt.values); err == nil {
+			t.Errorf("%v: expected error for %q", tt.keys, tt.values)
+		} else if _, ok := err.(invalidError); !ok {
+			t.Errorf("%v: expected invalidError for %q, got %T", tt.keys, tt.values, err)
+		}
+	}
+}
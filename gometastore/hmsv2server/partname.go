@@ -0,0 +1,110 @@
+// Partition names
+//
+// Partitions are identified by Hive-compatible names built from the table partition
+// keys and partition values, e.g. "ds=2017-01-01/hr=9". Keys and values are escaped
+// like Hive FileUtils.escapePathName does, so values containing "/" or "=" can't be
+// confused with multi-level names. Empty values use the Hive default partition name.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+)
+
+// defaultPartitionName is used for empty partition values
+const defaultPartitionName = "__HIVE_DEFAULT_PARTITION__"
+
+// needsEscape returns true for characters escaped in partition names
+func needsEscape(c byte) bool {
+	if c < 0x20 || c == 0x7F {
+		return true
+	}
+	switch c {
+	case '"', '#', '%', '\'', '*', '/', ':', '=', '?', '\\', '{', '[', ']', '^':
+		return true
+	}
+	return false
+}
+
+// escapePathName escapes partition key or value
+func escapePathName(s string) string {
+	if s == "" {
+		return defaultPartitionName
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if needsEscape(c) {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// unescapePathName reverses escapePathName. Invalid escapes are kept as is.
+func unescapePathName(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '%' && i+2 < len(s) {
+			if code, err := strconv.ParseUint(s[i+1:i+3], 16, 8); err == nil {
+				b.WriteByte(byte(code))
+				i += 2
+				continue
+			}
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// makePartName returns partition name for the values of the partition keys
+func makePartName(keys []*pb.FieldSchema, values []string) (string, error) {
+	if len(values) != len(keys) {
+		return "", invalidError(fmt.Sprintf("expected %d partition values, got %d",
+			len(keys), len(values)))
+	}
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = escapePathName(key.Name) + "=" + escapePathName(values[i])
+	}
+	return strings.Join(parts, "/"), nil
+}
+
+// parsePartName returns partition values from the partition name.
+// Keys in the name must match partition keys.
+func parsePartName(keys []*pb.FieldSchema, name string) ([]string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != len(keys) {
+		return nil, invalidError(fmt.Sprintf("partition name %s should have %d components",
+			name, len(keys)))
+	}
+	values := make([]string, len(parts))
+	for i, part := range parts {
+		eq := strings.Index(part, "=")
+		if eq < 0 {
+			return nil, invalidError(fmt.Sprintf("invalid partition name %s: missing value for %s",
+				name, part))
+		}
+		if key := unescapePathName(part[:eq]); key != keys[i].Name {
+			return nil, invalidError(fmt.Sprintf("invalid partition name %s: expected key %s, got %s",
+				name, keys[i].Name, key))
+		}
+		values[i] = unescapePathName(part[eq+1:])
+	}
+	return values, nil
+}
+
+// partitionName returns the name of the partition of the table with the values
+func partitionName(table *pb.Table, values []string) (string, error) {
+	return makePartName(table.PartitionKeys, values)
+}
@@ -0,0 +1,148 @@
+// Partition storage descriptors
+//
+// Partition storage descriptors are usually identical to the table one. To save space
+// partitions are stored with only the Sd fields which differ from the table Sd, and
+// names of fields taken from the table are listed in partition inherited_sd_fields.
+// Partitions are expanded on read, unless the client asks for compact partitions and
+// expands them using the table Sd sent with the first partition.
+//
+// Changes of the table Sd must expand and compact partitions again, otherwise
+// partitions would inherit the new table fields.
+
+package main
+
+import (
+	"bytes"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+)
+
+// sdField is a group of StorageDescriptor fields inherited together
+type sdField struct {
+	name string
+	copy func(dst, src *pb.StorageDescriptor) // copies the group from src to dst
+}
+
+var sdFields = []sdField{
+	{"cols", func(dst, src *pb.StorageDescriptor) {
+		dst.Cols = src.Cols
+	}},
+	{"inputformat", func(dst, src *pb.StorageDescriptor) {
+		dst.InputFormat, dst.InputFormatName = src.InputFormat, src.InputFormatName
+	}},
+	{"outputformat", func(dst, src *pb.StorageDescriptor) {
+		dst.OutputFormat, dst.OutputFormatName = src.OutputFormat, src.OutputFormatName
+	}},
+	{"numbuckets", func(dst, src *pb.StorageDescriptor) {
+		dst.NumBuckets = src.NumBuckets
+	}},
+	{"serdeinfo", func(dst, src *pb.StorageDescriptor) {
+		dst.SerdeInfo = src.SerdeInfo
+	}},
+	{"bucketcols", func(dst, src *pb.StorageDescriptor) {
+		dst.BucketCols = src.BucketCols
+	}},
+	{"sortcols", func(dst, src *pb.StorageDescriptor) {
+		dst.SortCols = src.SortCols
+	}},
+	{"parameters", func(dst, src *pb.StorageDescriptor) {
+		dst.Parameters = src.Parameters
+	}},
+	{"system_parameters", func(dst, src *pb.StorageDescriptor) {
+		dst.SystemParameters = src.SystemParameters
+	}},
+}
+
+// emptySd is used to clear field groups and to check whether they are set
+var emptySd = new(pb.StorageDescriptor)
+
+// fieldOf returns StorageDescriptor with only the field group set
+func (f *sdField) fieldOf(sd *pb.StorageDescriptor) *pb.StorageDescriptor {
+	result := new(pb.StorageDescriptor)
+	f.copy(result, sd)
+	return result
+}
+
+// compactSd removes fields equal to the table Sd from the partition Sd.
+// Partitions which are already compact are not changed.
+func compactSd(tableSd *pb.StorageDescriptor, partition *pb.Partition) {
+	if tableSd == nil || partition.Sd == nil || len(partition.InheritedSdFields) != 0 {
+		return
+	}
+	for i := range sdFields {
+		f := &sdFields[i]
+		tableField := f.fieldOf(tableSd)
+		if proto.Equal(tableField, emptySd) || !proto.Equal(tableField, f.fieldOf(partition.Sd)) {
+			continue
+		}
+		f.copy(partition.Sd, emptySd)
+		partition.InheritedSdFields = append(partition.InheritedSdFields, f.name)
+	}
+}
+
+// expandSd restores fields inherited from the table Sd.
+func expandSd(tableSd *pb.StorageDescriptor, partition *pb.Partition) {
+	if len(partition.InheritedSdFields) == 0 {
+		return
+	}
+	if partition.Sd == nil {
+		partition.Sd = new(pb.StorageDescriptor)
+	}
+	if tableSd == nil {
+		tableSd = emptySd
+	}
+	for _, name := range partition.InheritedSdFields {
+		for i := range sdFields {
+			if sdFields[i].name == name {
+				sdFields[i].copy(partition.Sd, tableSd)
+			}
+		}
+	}
+	partition.InheritedSdFields = nil
+}
+
+// rebasePartitions expands partitions with the old table Sd and compacts them with
+// the new one, so partitions keep their storage descriptors when the table Sd changes.
+// With cascade partitions get the new table columns and schema version instead.
+func rebasePartitions(partBucket *bolt.Bucket, oldSd, newSd *pb.StorageDescriptor,
+	cascade bool, schemaVersion int32) error {
+	// Partitions can't be updated while iterating
+	updates := make(map[string][]byte)
+	err := partBucket.ForEach(func(k, v []byte) error {
+		if v == nil {
+			return nil
+		}
+		partition := new(pb.Partition)
+		if err := proto.Unmarshal(v, partition); err != nil {
+			return err
+		}
+		expandSd(oldSd, partition)
+		if cascade {
+			if partition.Sd == nil {
+				partition.Sd = new(pb.StorageDescriptor)
+			}
+			partition.Sd.Cols = newSd.GetCols()
+			partition.SchemaVersion = schemaVersion
+		}
+		compactSd(newSd, partition)
+		data, err := proto.Marshal(partition)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(data, v) {
+			updates[string(k)] = data
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for k, data := range updates {
+		if err := partBucket.Put([]byte(k), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
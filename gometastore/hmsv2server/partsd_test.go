@@ -0,0 +1,128 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+)
+
+// testSd returns storage descriptor with the columns and common table fields
+func testSd(cols ...string) *pb.StorageDescriptor {
+	return &pb.StorageDescriptor{
+		Cols:        testColumns(cols...),
+		InputFormat: pb.InputFormat_IF_TEXT,
+		SerdeInfo:   &pb.SerDeInfo{Name: "serde", SerializationLib: "lib"},
+		Parameters:  map[string]string{"k": "v"},
+	}
+}
+
+func TestCompactExpandSd(t *testing.T) {
+	tableSd := testSd("a", "int")
+	tests := []struct {
+		name      string
+		sd        *pb.StorageDescriptor
+		inherited []string
+	}{
+		{"same as table", testSd("a", "int"), []string{"cols", "inputformat", "serdeinfo", "parameters"}},
+		{"own columns", testSd("a", "bigint"), []string{"inputformat", "serdeinfo", "parameters"}},
+		{
+			name: "own fields",
+			sd: &pb.StorageDescriptor{
+				Cols:       testColumns("a", "int"),
+				NumBuckets: 4,
+				BucketCols: []string{"a"},
+				Parameters: map[string]string{"k": "other"},
+			},
+			inherited: []string{"cols"},
+		},
+		{"empty", &pb.StorageDescriptor{}, nil},
+		{"missing", nil, nil},
+	}
+	for _, tt := range tests {
+		partition := &pb.Partition{Values: []string{"1"}, Sd: tt.sd}
+		original := proto.Clone(partition).(*pb.Partition)
+		compactSd(tableSd, partition)
+		if !equalValues(partition.InheritedSdFields, tt.inherited) {
+			t.Errorf("%s: inherited %q, want %q", tt.name, partition.InheritedSdFields, tt.inherited)
+		}
+		// Compacting again doesn't change the partition
+		compacted := proto.Clone(partition).(*pb.Partition)
+		compactSd(tableSd, partition)
+		if !proto.Equal(partition, compacted) {
+			t.Errorf("%s: compacted again to %v, want %v", tt.name, partition, compacted)
+		}
+		expandSd(tableSd, partition)
+		if !proto.Equal(partition, original) {
+			t.Errorf("%s: expanded to %v, want %v", tt.name, partition, original)
+		}
+	}
+}
+
+func TestRebasePartitions(t *testing.T) {
+	oldSd := testSd("a", "int")
+	newSd := testSd("a", "int", "b", "string")
+	newSd.InputFormat = pb.InputFormat_IF_SEQUENCE
+	newSd.Parameters = nil
+	sds := map[string]*pb.StorageDescriptor{
+		"same as table": testSd("a", "int"),
+		"own columns":   testSd("a", "bigint"),
+		"own format":    {Cols: testColumns("a", "int"), InputFormat: pb.InputFormat_IF_HIVE},
+		"missing":       nil,
+	}
+	tests := []struct {
+		name    string
+		cascade bool
+	}{
+		{"keep", false},
+		{"cascade", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDB(t)
+			err := db.Update(func(tx *bolt.Tx) error {
+				partBucket, err := tx.CreateBucket([]byte("parts"))
+				if err != nil {
+					return err
+				}
+				for key, sd := range sds {
+					partition := proto.Clone(
+						&pb.Partition{Values: []string{key}, Sd: sd, SchemaVersion: 1}).(*pb.Partition)
+					compactSd(oldSd, partition)
+					if err := partBucket.Put([]byte(key), mustMarshal(t, partition)); err != nil {
+						return err
+					}
+				}
+				return rebasePartitions(partBucket, oldSd, newSd, tt.cascade, 2)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			db.View(func(tx *bolt.Tx) error {
+				partBucket := tx.Bucket([]byte("parts"))
+				for key, sd := range sds {
+					var partition pb.Partition
+					if err := proto.Unmarshal(partBucket.Get([]byte(key)), &partition); err != nil {
+						t.Fatalf("%s: %v", key, err)
+					}
+					expandSd(newSd, &partition)
+					want := &pb.Partition{Values: []string{key}, Sd: sd, SchemaVersion: 1}
+					if tt.cascade {
+						// Only the columns and the schema version change
+						want.Sd = proto.Clone(sd).(*pb.StorageDescriptor)
+						if sd == nil {
+							want.Sd = new(pb.StorageDescriptor)
+						}
+						want.Sd.Cols = newSd.Cols
+						want.SchemaVersion = 2
+					}
+					if !proto.Equal(&partition, want) {
+						t.Errorf("%s: rebased to %v, want %v", key, &partition, want)
+					}
+				}
+				return nil
+			})
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Partition values
+//
+// Partition values are checked against the types of table partition keys and stored
+// in the canonical form of the type, so that e.g. "01" and "1" of an int key refer to
+// the same partition. Values of types without a canonical form are kept as is;
+// float and double values are checked, but keep their text since different texts of
+// the same number have the same partition key.
+// Empty values (and the default partition name) represent NULL and are not checked.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/akolb1/hmsv2api/gometastore/hivetype"
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+)
+
+const (
+	dateLayout      = "2006-01-02"
+	timestampLayout = "2006-01-02 15:04:05.999999999"
+)
+
+var decimalRegexp = regexp.MustCompile(`^([+-]?)([0-9]*)(?:\.([0-9]*))?$`)
+
+// intBits maps Hive integer types to their size
+var intBits = map[hivetype.Kind]int{
+	hivetype.TinyInt:  8,
+	hivetype.SmallInt: 16,
+	hivetype.Int:      32,
+	hivetype.BigInt:   64,
+}
+
+// floatBits maps Hive floating point types to their size
+var floatBits = map[hivetype.Kind]int{
+	hivetype.Float:  32,
+	hivetype.Double: 64,
+}
+
+// normalizePartValues checks that there is a valid value for every partition key
+// and returns values in the canonical form.
+func normalizePartValues(table *pb.Table, values []string) ([]string, error) {
+	keys := table.PartitionKeys
+	if len(values) != len(keys) {
+		return nil, invalidError(fmt.Sprintf("table %s has %d partition keys, got %d values",
+			table.GetId().GetName(), len(keys), len(values)))
+	}
+	return normalizeLeadingValues(keys, values)
+}
+
+// normalizeLeadingValues returns values of the leading partition keys in the
+// canonical form.
+func normalizeLeadingValues(keys []*pb.FieldSchema, values []string) ([]string, error) {
+	if len(values) > len(keys) {
+		return nil, invalidError(fmt.Sprintf("expected at most %d partition values, got %d",
+			len(keys), len(values)))
+	}
+	result := make([]string, len(values))
+	for i, value := range values {
+		key := keys[i]
+		value, err := normalizePartValue(key.Type, value)
+		if err != nil {
+			return nil, invalidError(fmt.Sprintf("invalid value %q for partition key %s of type %s: %v",
+				values[i], key.Name, key.Type, err))
+		}
+		result[i] = value
+	}
+	return result, nil
+}
+
+// normalizePartValue returns value of the Hive type in the canonical form
+func normalizePartValue(typ string, value string) (string, error) {
+	if value == "" || value == defaultPartitionName {
+		return value, nil
+	}
+	t := partitionKeyType(typ)
+	if t == nil {
+		return value, nil
+	}
+	if bits, ok := intBits[t.Kind]; ok {
+		v, err := strconv.ParseInt(value, 10, bits)
+		if err != nil {
+			return "", fmt.Errorf("not a %d-bit integer", bits)
+		}
+		return strconv.FormatInt(v, 10), nil
+	}
+	if bits, ok := floatBits[t.Kind]; ok {
+		if _, err := strconv.ParseFloat(value, bits); err != nil {
+			return "", fmt.Errorf("not a %d-bit floating point number", bits)
+		}
+		return value, nil
+	}
+	switch t.Kind {
+	case hivetype.Date:
+		t, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return "", fmt.Errorf("expected yyyy-mm-dd")
+		}
+		return t.Format(dateLayout), nil
+	case hivetype.Timestamp:
+		// Parsing accepts fractional seconds which are not in the layout
+		t, err := time.Parse("2006-01-02 15:04:05", value)
+		if err != nil {
+			return "", fmt.Errorf("expected yyyy-mm-dd hh:mm:ss[.fffffffff]")
+		}
+		return t.Format(timestampLayout), nil
+	case hivetype.Decimal:
+		return normalizeDecimal(value, t.Precision, t.Scale)
+	case hivetype.Char, hivetype.Varchar:
+		if utf8.RuneCountInString(value) > t.Length {
+			return "", fmt.Errorf("longer than %d characters", t.Length)
+		}
+	}
+	return value, nil
+}
+
+// splitDecimal returns sign, integer part without leading zeroes and fraction
+// without trailing zeroes of the decimal number.
+func splitDecimal(value string) (string, string, string, error) {
+	m := decimalRegexp.FindStringSubmatch(value)
+	if m == nil || m[2] == "" && m[3] == "" {
+		return "", "", "", fmt.Errorf("not a decimal number")
+	}
+	return m[1], strings.TrimLeft(m[2], "0"), strings.TrimRight(m[3], "0"), nil
+}
+
+// normalizeDecimal removes sign of zero, leading zeroes of the integer part and
+// trailing zeroes of the fraction. Values which don't fit the precision and scale
+// are rejected rather than rounded.
+func normalizeDecimal(value string, precision, scale int) (string, error) {
+	sign, intPart, fraction, err := splitDecimal(value)
+	if err != nil {
+		return "", err
+	}
+	if len(fraction) > scale {
+		return "", fmt.Errorf("more than %d digits after the decimal point", scale)
+	}
+	if len(intPart) > precision-scale {
+		return "", fmt.Errorf("more than %d digits before the decimal point", precision-scale)
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	if sign == "+" || intPart == "0" && fraction == "" {
+		sign = ""
+	}
+	if fraction != "" {
+		return sign + intPart + "." + fraction, nil
+	}
+	return sign + intPart, nil
+}
+
+// equalValues returns true if both lists have the same partition values
+func equalValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+// Principal handling
+//
+// Each request is associated with a principal - the identity of the caller.
+// With mutual TLS the principal is taken from the subject of the verified client certificate.
+
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type principalKey struct{}
+
+// withPrincipal returns a copy of the context carrying the principal name.
+func withPrincipal(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// principalFromContext returns the principal associated with the request or an empty
+// string if it is unknown.
+func principalFromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(principalKey{}).(string)
+	return principal
+}
+
+// tlsPrincipal returns principal name from the verified client certificate.
+// Common name is used when present, otherwise the full subject.
+func tlsPrincipal(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ""
+	}
+	chains := tlsInfo.State.VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return ""
+	}
+	subject := chains[0][0].Subject
+	if subject.CommonName != "" {
+		return subject.CommonName
+	}
+	return subject.String()
+}
+
+// principalContext attaches the principal from the client certificate to the context.
+func principalContext(ctx context.Context) context.Context {
+	if principal := tlsPrincipal(ctx); principal != "" {
+		return withPrincipal(ctx, principal)
+	}
+	return ctx
+}
+
+// wrappedStream allows interceptors to replace stream context.
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
+
+func tlsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(principalContext(ctx), req)
+}
+
+func tlsStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return handler(srv, &wrappedStream{stream, principalContext(stream.Context())})
+}
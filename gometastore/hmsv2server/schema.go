@@ -0,0 +1,162 @@
+// Table schema history
+//
+// Every database bucket has a SCHEMAS bucket with a sub-bucket per table, keyed by
+// table ID, which maps schema versions to the table columns of this version:
+//
+//   SCHEMAS+
+//          + <tbl id>
+//                <version> -> { TableSchema }
+//
+// Versions are stored as big-endian uint32 so they are sorted. Tables start with
+// version 1 and get a new version whenever their columns change. Partitions record
+// the version of the table schema they were written with, partitions written before
+// versioning have version 0 and are treated as version 1.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+)
+
+const schemasHdr = "SCHEMAS"
+
+func schemaKey(version int32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, uint32(version))
+	return key
+}
+
+// tableSchema returns the current schema of the table
+func tableSchema(table *pb.Table) *pb.TableSchema {
+	return &pb.TableSchema{
+		Version:    table.SchemaVersion,
+		Cols:       table.GetSd().GetCols(),
+		CreateTime: table.LastModifiedTime,
+		CreatedBy:  table.ModifiedBy,
+	}
+}
+
+// getSchemaBucket returns schema history bucket of the table, nil if there is none
+func getSchemaBucket(dbBucket *bolt.Bucket, tableID []byte) *bolt.Bucket {
+	schemas := dbBucket.Bucket([]byte(schemasHdr))
+	if schemas == nil {
+		return nil
+	}
+	return schemas.Bucket(tableID)
+}
+
+// putTableSchema adds schema version to the table history
+func putTableSchema(dbBucket *bolt.Bucket, tableID []byte, schema *pb.TableSchema) error {
+	schemas, err := dbBucket.CreateBucketIfNotExists([]byte(schemasHdr))
+	if err != nil {
+		return err
+	}
+	history, err := schemas.CreateBucketIfNotExists(tableID)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	return history.Put(schemaKey(schema.Version), data)
+}
+
+// getTableSchema returns schema version from the table history
+func getTableSchema(dbBucket *bolt.Bucket, tableID []byte, version int32) (*pb.TableSchema, error) {
+	history := getSchemaBucket(dbBucket, tableID)
+	if history == nil {
+		return nil, fmt.Errorf("corrupt catalog: no schema history for table %s", tableID)
+	}
+	data := history.Get(schemaKey(version))
+	if data == nil {
+		return nil, fmt.Errorf("corrupt catalog: no schema version %d for table %s",
+			version, tableID)
+	}
+	schema := new(pb.TableSchema)
+	if err := proto.Unmarshal(data, schema); err != nil {
+		return nil, fmt.Errorf("can't decode schema version %d of table %s: %v",
+			version, tableID, err)
+	}
+	return schema, nil
+}
+
+// dropTableSchemas removes schema history of the table
+func dropTableSchemas(dbBucket *bolt.Bucket, tableID []byte) error {
+	schemas := dbBucket.Bucket([]byte(schemasHdr))
+	if schemas == nil {
+		return nil
+	}
+	if err := schemas.DeleteBucket(tableID); err != nil && err != bolt.ErrBucketNotFound {
+		return err
+	}
+	return nil
+}
+
+// partitionSchemaVersion returns table schema version the partition was written with
+func partitionSchemaVersion(partition *pb.Partition) int32 {
+	if partition.SchemaVersion == 0 {
+		return 1
+	}
+	return partition.SchemaVersion
+}
+
+// addSchemaHistory starts schema history of all existing tables with version 1
+func addSchemaHistory(tx *bolt.Tx) error {
+	return forEachCatalog(tx, func(name []byte, catBucket *bolt.Bucket) error {
+		dbBuckets := catBucket.Bucket([]byte(dbHdr))
+		if dbBuckets == nil {
+			return nil
+		}
+		var dbIDs [][]byte
+		dbBuckets.ForEach(func(k, v []byte) error {
+			if v == nil {
+				dbIDs = append(dbIDs, copyBytes(k))
+			}
+			return nil
+		})
+		for _, dbID := range dbIDs {
+			if err := addDatabaseSchemaHistory(dbBuckets.Bucket(dbID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func addDatabaseSchemaHistory(dbBucket *bolt.Bucket) error {
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	if byIDBucket == nil {
+		return nil
+	}
+	// Tables can't be updated while iterating
+	tables := make(map[string]*pb.Table)
+	byIDBucket.ForEach(func(k, v []byte) error {
+		table := new(pb.Table)
+		if proto.Unmarshal(v, table) == nil && table.SchemaVersion == 0 {
+			tables[string(k)] = table
+		}
+		return nil
+	})
+	for id, table := range tables {
+		table.SchemaVersion = 1
+		data, err := proto.Marshal(table)
+		if err != nil {
+			return err
+		}
+		if err = byIDBucket.Put([]byte(id), data); err != nil {
+			return err
+		}
+		schema := tableSchema(table)
+		schema.CreateTime, schema.CreatedBy = table.CreateTime, table.CreatedBy
+		if err = putTableSchema(dbBucket, []byte(id), schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -5,8 +5,11 @@
 // root+
 //   catalog1+
 //           |
+//           + CATALOG -> { Catalog }
+//           + IDX    Id -> location, see idindex.go
 //           + BYNAME Name -> Id
 //           + BYID   Id -> { Database }
+//           + TRASH  dropped tables and databases, see trash.go
 //           + DB +
 //                |
 //                +<id1>
@@ -19,16 +22,20 @@
 //                       + <id2>
 //                            DATA
 //                            PARTS
+//                    SCHEMAS, see schema.go
 //                |
 //                + <id2>
 //                    DATA
 //                    TBLS
+//   __META__+
+//           + version -> layout version, see migrate.go
 //
 
 package main
 
 import (
 	"strings"
+	"time"
 
 	"fmt"
 
@@ -45,11 +52,54 @@ const (
 )
 
 type metastoreServer struct {
-	db *bolt.DB
+	db             *bolt.DB
+	trashRetention time.Duration // Time dropped objects are kept in trash
 }
 
-func newServer(db *bolt.DB) *metastoreServer {
-	return &metastoreServer{db: db}
+func newServer(db *bolt.DB, trashRetention time.Duration) *metastoreServer {
+	return &metastoreServer{db: db, trashRetention: trashRetention}
+}
+
+// statusGetter is implemented by responses carrying RequestStatus
+type statusGetter interface {
+	GetStatus() *pb.RequestStatus
+}
+
+// responseStatus returns RequestStatus of the RPC response or nil if there is none.
+func responseStatus(resp interface{}) *pb.RequestStatus {
+	if r, ok := resp.(*pb.RequestStatus); ok {
+		return r
+	}
+	if r, ok := resp.(statusGetter); ok {
+		return r.GetStatus()
+	}
+	return nil
+}
+
+// validCatalog checks that the name can be used as a catalog name.
+func validCatalog(catalog string) error {
+	if catalog == "" {
+		return fmt.Errorf("missing catalog")
+	}
+	if catalog == metaBucket {
+		return fmt.Errorf("catalog name %s is reserved", catalog)
+	}
+	return nil
+}
+
+// forEachCatalog calls fn for every catalog bucket.
+func forEachCatalog(tx *bolt.Tx, fn func(name []byte, catBucket *bolt.Bucket) error) error {
+	return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		if string(name) == metaBucket {
+			return nil
+		}
+		return fn(name, b)
+	})
+}
+
+// nowMillis returns current time in milliseconds since epoch
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
 }
 
 // Table ops
@@ -65,9 +115,9 @@ func getULID() string {
 //   catalog - catalog name, must be non-empty
 //   db - Database ID, must be non-empty and either name or Id should be specified
 func getDatabaseBucket(tx *bolt.Tx, catalog string, db *pb.Id) (bucket *bolt.Bucket, err error) {
-	catBucket := tx.Bucket([]byte(catalog))
-	if catBucket == nil {
-		return nil, fmt.Errorf("missing catalog %s", catalog)
+	catBucket, err := getCatalogBucket(tx, catalog)
+	if err != nil {
+		return nil, err
 	}
 	idMap := catBucket.Bucket([]byte(byIDHdr))
 	if idMap == nil {
@@ -82,7 +132,7 @@ func getDatabaseBucket(tx *bolt.Tx, catalog string, db *pb.Id) (bucket *bolt.Buc
 		}
 		idBytesDb = nameIDBucket.Get([]byte(db.Name))
 		if idBytesDb == nil {
-			return nil, fmt.Errorf("database %s doesn't exist", db.Name)
+			return nil, notFoundError(fmt.Sprintf("database %s doesn't exist", db.Name))
 		}
 	}
 	dbInfoBucket := catBucket.Bucket([]byte(dbHdr))
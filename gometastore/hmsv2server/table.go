@@ -27,7 +27,6 @@ package main
 
 import (
 	"fmt"
-	"log"
 
 	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
 	"github.com/boltdb/bolt"
@@ -39,7 +38,8 @@ import (
 
 func (s *metastoreServer) CreateTable(c context.Context,
 	req *pb.CreateTableRequest) (*pb.GetTableResponse, error) {
-	log.Println("CreateTable:", req)
+	logger := requestLogger(c)
+	logger.Debug("CreateTable: ", req)
 	if req.Table == nil || req.Table.Id == nil {
 		return nil, fmt.Errorf("missing Table info")
 	}
@@ -65,10 +65,23 @@ func (s *metastoreServer) CreateTable(c context.Context,
 	if tableName == "" {
 		return nil, fmt.Errorf("missing table name")
 	}
+	if err := validateTable(table); err != nil {
+		logger.WithError(err).Warn("invalid table schema")
+		return &pb.GetTableResponse{Status: errorStatus(err)}, nil
+	}
 	table.Id.Id = getULID()
 	id := table.Id.Id
+	now := nowMillis()
+	principal := principalFromContext(c)
+	table.CreateTime, table.LastModifiedTime, table.LastAccessTime = now, now, now
+	table.CreatedBy, table.ModifiedBy = principal, principal
+	table.SchemaVersion = 1
 
-	err := s.db.Update(func(tx *bolt.Tx) error {
+	err := s.update(c, func(tx *bolt.Tx) error {
+		_, _, dbID, err := getDatabaseID(tx, catalog, req.DbId)
+		if err != nil {
+			return err
+		}
 		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
 		if err != nil {
 			return err
@@ -108,15 +121,20 @@ func (s *metastoreServer) CreateTable(c context.Context,
 		if err != nil {
 			return err
 		}
+		if err = putTableSchema(dbBucket, []byte(id), tableSchema(table)); err != nil {
+			return err
+		}
 
-		return nil
+		idx, err := getCatalogIDIndex(tx, catalog)
+		if err != nil {
+			return err
+		}
+		return putIDLocation(idx, id, &idLocation{Kind: kindTable, DbID: string(dbID)})
 	})
 
 	if err != nil {
-		log.Println("failed to create table:", err)
-		return &pb.GetTableResponse{
-			Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_ERROR, Error: err.Error()},
-		}, nil
+		logger.WithError(err).Warn("failed to create table")
+		return &pb.GetTableResponse{Status: errorStatus(err)}, nil
 	}
 
 	return &pb.GetTableResponse{
@@ -127,7 +145,8 @@ func (s *metastoreServer) CreateTable(c context.Context,
 
 func (s *metastoreServer) GetTable(c context.Context,
 	req *pb.GetTableRequest) (*pb.GetTableResponse, error) {
-	log.Println("GetTable:", req)
+	logger := requestLogger(c)
+	logger.Debug("GetTable: ", req)
 
 	if req.Id == nil {
 		return nil, fmt.Errorf("missing identity info")
@@ -153,7 +172,7 @@ func (s *metastoreServer) GetTable(c context.Context,
 
 	var table pb.Table
 
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.view(c, func(tx *bolt.Tx) error {
 		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
 		if err != nil {
 			return err
@@ -168,7 +187,7 @@ func (s *metastoreServer) GetTable(c context.Context,
 		}
 		tblIDBytes := byNameBucket.Get([]byte(tableName))
 		if tblIDBytes == nil {
-			return fmt.Errorf("table %s:%s.%s does not exist", catalog, dbName, tableName)
+			return notFoundError(fmt.Sprintf("table %s:%s.%s does not exist", catalog, dbName, tableName))
 		}
 		data := byIDBucket.Get(tblIDBytes)
 		if data == nil {
@@ -184,10 +203,8 @@ func (s *metastoreServer) GetTable(c context.Context,
 	})
 
 	if err != nil {
-		log.Println("failed to get table:", err)
-		return &pb.GetTableResponse{
-			Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_ERROR, Error: err.Error()},
-		}, nil
+		logger.WithError(err).Warn("failed to get table")
+		return &pb.GetTableResponse{Status: errorStatus(err)}, nil
 	}
 
 	return &pb.GetTableResponse{
@@ -198,7 +215,8 @@ func (s *metastoreServer) GetTable(c context.Context,
 
 func (s *metastoreServer) ListTables(req *pb.ListTablesRequest,
 	stream pb.Metastore_ListTablesServer) error {
-	log.Println("ListTables", req)
+	logger := requestLogger(stream.Context())
+	logger.Debug("ListTables: ", req)
 	if req.DbId == nil {
 		return fmt.Errorf("Missing db ID")
 	}
@@ -211,7 +229,7 @@ func (s *metastoreServer) ListTables(req *pb.ListTablesRequest,
 		return fmt.Errorf("missing db name")
 	}
 
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.view(stream.Context(), func(tx *bolt.Tx) error {
 		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
 		if err != nil {
 			return err
@@ -225,6 +243,9 @@ func (s *metastoreServer) ListTables(req *pb.ListTablesRequest,
 			if err := proto.Unmarshal(v, table); err != nil {
 				return err
 			}
+			if table.LastModifiedTime < req.ModifiedSince {
+				return nil
+			}
 
 			if len(req.GetFields()) != 0 {
 				// Only include specified fields
@@ -247,14 +268,13 @@ func (s *metastoreServer) ListTables(req *pb.ListTablesRequest,
 						tbl.PartitionKeys = table.PartitionKeys
 					}
 				}
-				log.Println("send", tbl)
 				if err := stream.Send(tbl); err != nil {
-					log.Println("err sending ", err)
+					logger.WithError(err).Warn("failed to send table")
 					return err
 				}
 			} else {
 				if err := stream.Send(table); err != nil {
-					log.Println("err sending ", err)
+					logger.WithError(err).Warn("failed to send table")
 					return err
 				}
 			}
@@ -265,16 +285,196 @@ func (s *metastoreServer) ListTables(req *pb.ListTablesRequest,
 	})
 
 	if err != nil {
-		log.Println("failed to list tables:", err)
-		return err
+		logger.WithError(err).Warn("failed to list tables")
+		return streamError(err)
 	}
 
 	return nil
 }
 
+func (s *metastoreServer) AlterTable(c context.Context,
+	req *pb.AlterTableRequest) (*pb.AlterTableResponse, error) {
+	logger := requestLogger(c)
+	logger.Debug("AlterTable: ", req)
+	if req.Table == nil {
+		return nil, fmt.Errorf("missing table data")
+	}
+	if req.Id == nil {
+		return nil, fmt.Errorf("missing identity info")
+	}
+	if req.DbId == nil {
+		return nil, fmt.Errorf("missing DB info")
+	}
+	catalog := req.Catalog
+	if catalog == "" {
+		return nil, fmt.Errorf("missing catalog")
+	}
+	dbName := req.DbId.Name
+	if dbName == "" {
+		return nil, fmt.Errorf("missing database name")
+	}
+	tableName := req.Id.Name
+	if tableName == "" {
+		return nil, fmt.Errorf("missing table name")
+	}
+	// Source table
+	src := req.Table
+	fields := req.UpdateMask
+	if len(fields) == 0 {
+		fields = setTableFields(src)
+	}
+	for _, field := range fields {
+		if _, ok := alterableTableFields[field]; !ok {
+			return nil, fmt.Errorf("table field %q can't be altered", field)
+		}
+		if field == "sd" && src.Sd == nil {
+			return nil, fmt.Errorf("missing storage descriptor")
+		}
+	}
+
+	var table *pb.Table
+	err := s.update(c, func(tx *bolt.Tx) error {
+		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
+		if err != nil {
+			return err
+		}
+		old, tableID, err := getTable(dbBucket, catalog, dbName, tableName)
+		if err != nil {
+			return err
+		}
+		if len(req.Table.PartitionKeys) != 0 && !samePartitionKeys(old.PartitionKeys, req.Table.PartitionKeys) {
+			return invalidError(fmt.Sprintf("partition keys of table %s.%s can't be changed",
+				dbName, tableName))
+		}
+
+		table = proto.Clone(old).(*pb.Table)
+		for _, field := range fields {
+			alterableTableFields[field](table, src)
+		}
+		table.LastModifiedTime = nowMillis()
+		table.ModifiedBy = principalFromContext(c)
+		return alterTable(dbBucket, tableID, old, table, false)
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to alter table")
+		response := &pb.AlterTableResponse{Status: errorStatus(err)}
+		if conflict, ok := err.(schemaConflictError); ok {
+			response.Incompatibilities = conflict
+		}
+		return response, nil
+	}
+
+	return &pb.AlterTableResponse{
+		Status: &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK},
+		Table:  table,
+	}, nil
+}
+
+// alterableTableFields maps names of table fields which AlterTable can change to
+// functions copying them from the request
+var alterableTableFields = map[string]func(table, src *pb.Table){
+	"sd":               func(table, src *pb.Table) { table.Sd = src.Sd },
+	"location":         func(table, src *pb.Table) { table.Location = src.Location },
+	"last_access_time": func(table, src *pb.Table) { table.LastAccessTime = src.LastAccessTime },
+	"table_type":       func(table, src *pb.Table) { table.TableType = src.TableType },
+	"parameters":       func(table, src *pb.Table) { table.Parameters = src.Parameters },
+}
+
+// setTableFields returns names of alterable fields which are set in the table
+func setTableFields(table *pb.Table) []string {
+	var fields []string
+	if table.Sd != nil {
+		fields = append(fields, "sd")
+	}
+	if table.Location != "" {
+		fields = append(fields, "location")
+	}
+	if table.LastAccessTime != 0 {
+		fields = append(fields, "last_access_time")
+	}
+	if table.TableType != pb.TableType_TTYPE_MANAGED {
+		fields = append(fields, "table_type")
+	}
+	if len(table.Parameters) != 0 {
+		fields = append(fields, "parameters")
+	}
+	return fields
+}
+
+// validateTable checks columns and parameters of a new table
+func validateTable(table *pb.Table) error {
+	if err := normalizeColumns(table); err != nil {
+		return err
+	}
+	_, err := compatibilityMode(table)
+	return err
+}
+
+// validateAlteredTable checks columns and parameters changed by the alter. Unchanged
+// columns and parameters of tables created before they were checked are kept as is.
+func validateAlteredTable(old, table *pb.Table) error {
+	if err := normalizeChangedColumns(old, table); err != nil {
+		return err
+	}
+	if table.Parameters[compatibilityParam] == old.Parameters[compatibilityParam] {
+		return nil
+	}
+	_, err := compatibilityMode(table)
+	return err
+}
+
+// alterTable stores the altered table. Column changes are checked against the
+// compatibility mode of the old table and create a new schema version. Partitions
+// inheriting fields of the table Sd are rebased on the new Sd, with cascade they get
+// the new columns as well.
+func alterTable(dbBucket *bolt.Bucket, tableID []byte, old, table *pb.Table, cascade bool) error {
+	if err := validateAlteredTable(old, table); err != nil {
+		return err
+	}
+	oldCols, newCols := old.GetSd().GetCols(), table.GetSd().GetCols()
+	if !equalColumns(oldCols, newCols) {
+		// Tables created before modes were checked may have invalid modes
+		mode, err := compatibilityMode(old)
+		if err != nil {
+			mode = compatNone
+		}
+		if problems := checkCompatibility(mode, oldCols, newCols); len(problems) != 0 {
+			return schemaConflictError(problems)
+		}
+		table.SchemaVersion = old.SchemaVersion + 1
+		if err = putTableSchema(dbBucket, tableID, tableSchema(table)); err != nil {
+			return err
+		}
+	}
+	cascade = cascade && table.SchemaVersion != old.SchemaVersion
+	if cascade || !proto.Equal(old.GetSd(), table.GetSd()) {
+		tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
+		if tablesBucket == nil {
+			return fmt.Errorf("corrupt catalog: no TBLS info for table %s", tableID)
+		}
+		if partBucket := tablesBucket.Bucket(tableID); partBucket != nil {
+			err := rebasePartitions(partBucket, old.Sd, table.Sd, cascade, table.SchemaVersion)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	if byIDBucket == nil {
+		return fmt.Errorf("corrupt catalog: no BYID info for table %s", tableID)
+	}
+	data, err := proto.Marshal(table)
+	if err != nil {
+		return err
+	}
+	return byIDBucket.Put(tableID, data)
+}
+
 func (s *metastoreServer) DropTable(c context.Context,
 	req *pb.DropTableRequest) (*pb.RequestStatus, error) {
-	log.Println("DropTable:", req)
+	logger := requestLogger(c)
+	logger.Debug("DropTable: ", req)
 	if req.Id == nil {
 		return nil, fmt.Errorf("missing identity info")
 	}
@@ -294,7 +494,7 @@ func (s *metastoreServer) DropTable(c context.Context,
 		return nil, fmt.Errorf("missing table name")
 	}
 
-	err := s.db.Update(func(tx *bolt.Tx) error {
+	err := s.update(c, func(tx *bolt.Tx) error {
 		dbBucket, err := getDatabaseBucket(tx, catalog, req.DbId)
 		if err != nil {
 			return err
@@ -309,20 +509,52 @@ func (s *metastoreServer) DropTable(c context.Context,
 		}
 		tblIDBytes := byNameBucket.Get([]byte(tableName))
 		if tblIDBytes == nil {
-			return fmt.Errorf("table %s:%s.%s does not exist", catalog, dbName, tableName)
+			return notFoundError(fmt.Sprintf("table %s:%s.%s does not exist", catalog, dbName, tableName))
 		}
 		tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
 		if tablesBucket == nil {
 			return fmt.Errorf("corrupt catalog %s/%s: no table info", catalog, dbName)
 		}
-		tablesBucket.DeleteBucket(tblIDBytes)
-		byIDBucket.Delete(tblIDBytes)
-		byNameBucket.Delete([]byte(tableName))
-		return nil
+		catBucket, err := getCatalogBucket(tx, catalog)
+		if err != nil {
+			return err
+		}
+		if !req.Purge && s.trashRetention > 0 {
+			_, _, dbID, err := getDatabaseID(tx, catalog, req.DbId)
+			if err != nil {
+				return err
+			}
+			info := s.newDroppedObject(c, pb.DroppedObject_DROPPED_TABLE,
+				&pb.Id{Name: tableName, Id: string(tblIDBytes)},
+				&pb.Id{Name: dbName, Id: string(dbID)})
+			err = trashTable(catBucket, dbBucket, info, byIDBucket.Get(tblIDBytes),
+				tablesBucket.Bucket(tblIDBytes))
+			if err != nil {
+				return err
+			}
+		}
+		idx, err := getIDIndex(catBucket)
+		if err != nil {
+			return err
+		}
+		if err := unindexTable(idx, tblIDBytes, tablesBucket.Bucket(tblIDBytes)); err != nil {
+			return err
+		}
+		// Partition bucket may be missing if table was never fully created
+		if err := tablesBucket.DeleteBucket(tblIDBytes); err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+		if err := dropTableSchemas(dbBucket, tblIDBytes); err != nil {
+			return err
+		}
+		if err := byIDBucket.Delete(tblIDBytes); err != nil {
+			return err
+		}
+		return byNameBucket.Delete([]byte(tableName))
 	})
 
 	if err != nil {
-		log.Println("failed to delete table:", err)
+		logger.WithError(err).Warn("failed to delete table")
 		return nil, err
 	}
 
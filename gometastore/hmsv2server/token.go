@@ -0,0 +1,292 @@
+// Bearer token authentication
+//
+// Clients authenticate by passing a signed JWT in the "authorization" gRPC metadata
+// as "Bearer <token>". hmsproxy forwards the HTTP Authorization header as this metadata.
+//
+// Tokens are verified either with a static HMAC key or with public keys from a local
+// JWKS file. The principal is taken from the configured claim ("sub" by default).
+
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authHeader   = "authorization"
+	bearerPrefix = "bearer "
+)
+
+// tokenVerifier validates bearer tokens and extracts principals from them.
+type tokenVerifier struct {
+	hmacKey        []byte
+	keys           map[string]interface{} // Public keys by key ID
+	issuer         string
+	audience       string
+	principalClaim string
+}
+
+// newTokenVerifier creates verifier using either HMAC key file or JWKS file.
+//
+//   hmacKeyFile - file containing shared HMAC secret
+//   jwksFile - file containing JSON Web Key Set with public keys
+//   issuer, audience - if non-empty, tokens must have matching iss and aud claims
+//   principalClaim - claim used as principal name
+func newTokenVerifier(hmacKeyFile string, jwksFile string, issuer string,
+	audience string, principalClaim string) (*tokenVerifier, error) {
+	if hmacKeyFile == "" && jwksFile == "" {
+		return nil, fmt.Errorf("either HMAC key or JWKS should be specified")
+	}
+	if principalClaim == "" {
+		principalClaim = "sub"
+	}
+	v := &tokenVerifier{issuer: issuer, audience: audience, principalClaim: principalClaim}
+	if hmacKeyFile != "" {
+		data, err := ioutil.ReadFile(hmacKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		v.hmacKey = []byte(strings.TrimSpace(string(data)))
+		if len(v.hmacKey) == 0 {
+			return nil, fmt.Errorf("empty HMAC key in %s", hmacKeyFile)
+		}
+	}
+	if jwksFile != "" {
+		keys, err := loadJWKS(jwksFile)
+		if err != nil {
+			return nil, err
+		}
+		v.keys = keys
+	}
+	return v, nil
+}
+
+// jsonWebKey is a subset of RFC 7517 key fields for RSA and EC public keys.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// loadJWKS reads public keys from JWKS file. Keys of unsupported types are ignored.
+func loadJWKS(jwksFile string) (map[string]interface{}, error) {
+	data, err := ioutil.ReadFile(jwksFile)
+	if err != nil {
+		return nil, err
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", jwksFile, err)
+	}
+	keys := make(map[string]interface{})
+	for _, k := range jwks.Keys {
+		switch k.Kty {
+		case "RSA":
+			n, err := decodeBigInt(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("invalid key %s: %v", k.Kid, err)
+			}
+			e, err := decodeBigInt(k.E)
+			if err != nil {
+				return nil, fmt.Errorf("invalid key %s: %v", k.Kid, err)
+			}
+			keys[k.Kid] = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case "EC":
+			var curve elliptic.Curve
+			switch k.Crv {
+			case "P-256":
+				curve = elliptic.P256()
+			case "P-384":
+				curve = elliptic.P384()
+			case "P-521":
+				curve = elliptic.P521()
+			default:
+				return nil, fmt.Errorf("invalid key %s: unsupported curve %s", k.Kid, k.Crv)
+			}
+			x, err := decodeBigInt(k.X)
+			if err != nil {
+				return nil, fmt.Errorf("invalid key %s: %v", k.Kid, err)
+			}
+			y, err := decodeBigInt(k.Y)
+			if err != nil {
+				return nil, fmt.Errorf("invalid key %s: %v", k.Kid, err)
+			}
+			keys[k.Kid] = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no usable keys in %s", jwksFile)
+	}
+	return keys, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// keyFunc returns verification key for the token based on its algorithm and key ID.
+func (v *tokenVerifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if v.hmacKey == nil {
+			return nil, fmt.Errorf("HMAC tokens are not accepted")
+		}
+		return v.hmacKey, nil
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		if v.keys == nil {
+			return nil, fmt.Errorf("signing method %s is not accepted", token.Method.Alg())
+		}
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := v.keys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(v.keys) == 1 {
+			for _, key := range v.keys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return nil, fmt.Errorf("unsupported signing method %s", token.Method.Alg())
+}
+
+// verify checks the token and returns the principal name.
+func (v *tokenVerifier) verify(tokenString string) (string, error) {
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, v.keyFunc); err != nil {
+		return "", err
+	}
+	if v.issuer != "" && !claims.VerifyIssuer(v.issuer, true) {
+		return "", fmt.Errorf("invalid issuer")
+	}
+	if v.audience != "" && !hasAudience(claims["aud"], v.audience) {
+		return "", fmt.Errorf("invalid audience")
+	}
+	principal, _ := claims[v.principalClaim].(string)
+	if principal == "" {
+		return "", fmt.Errorf("missing %s claim", v.principalClaim)
+	}
+	return principal, nil
+}
+
+// hasAudience checks aud claim which may be either a string or a list of strings
+func hasAudience(aud interface{}, audience string) bool {
+	switch a := aud.(type) {
+	case string:
+		return a == audience
+	case []interface{}:
+		for _, v := range a {
+			if s, ok := v.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// authenticate verifies bearer token from request metadata and returns context
+// with the principal. Requests without a token are accepted only if the principal
+// is already known from the client certificate.
+func (v *tokenVerifier) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md[authHeader]
+	if len(values) == 0 {
+		if principalFromContext(ctx) != "" {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	principal, err := v.verifyHeader(values[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return withPrincipal(ctx, principal), nil
+}
+
+// verifyHeader checks "Bearer <token>" authorization header and returns the principal.
+func (v *tokenVerifier) verifyHeader(header string) (string, error) {
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", fmt.Errorf("invalid authorization header")
+	}
+	principal, err := v.verify(strings.TrimSpace(header[len(bearerPrefix):]))
+	if err != nil {
+		return "", fmt.Errorf("invalid token: %v", err)
+	}
+	return principal, nil
+}
+
+// httpHandler authenticates HTTP requests like gRPC calls: requests need a valid
+// bearer token in the Authorization header unless the client presented a verified
+// certificate.
+func (v *tokenVerifier) httpHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) != 0 {
+			h.ServeHTTP(w, r)
+			return
+		}
+		header := r.Header.Get(authHeader)
+		if header == "" {
+			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			return
+		}
+		if _, err := v.verifyHeader(header); err != nil {
+			log.WithField("path", r.URL.Path).Warn(err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (v *tokenVerifier) unaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isHealthCheck(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	authCtx, err := v.authenticate(ctx)
+	if err != nil {
+		requestLogger(ctx).WithField("method", info.FullMethod).Warn(err)
+		return nil, err
+	}
+	return handler(authCtx, req)
+}
+
+func (v *tokenVerifier) streamInterceptor(srv interface{}, stream grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isHealthCheck(info.FullMethod) {
+		return handler(srv, stream)
+	}
+	ctx, err := v.authenticate(stream.Context())
+	if err != nil {
+		requestLogger(stream.Context()).WithField("method", info.FullMethod).Warn(err)
+		return err
+	}
+	return handler(srv, &wrappedStream{stream, ctx})
+}
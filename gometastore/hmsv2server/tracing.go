@@ -0,0 +1,79 @@
+// Tracing
+//
+// RPC spans are created by the otelgrpc stats handler which also extracts W3C trace
+// context from incoming metadata. Handlers add child spans for Bolt transactions and
+// list streams get a span covering the whole stream of results.
+
+package main
+
+import (
+	"context"
+	"path"
+
+	"github.com/boltdb/bolt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+var tracer = otel.Tracer("github.com/akolb1/hmsv2api/gometastore/hmsv2server")
+
+// endSpan records error, if any, and ends the span
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// view runs fn in a read-only Bolt transaction traced as a child span of ctx.
+func (s *metastoreServer) view(ctx context.Context, fn func(tx *bolt.Tx) error) error {
+	_, span := tracer.Start(ctx, "bolt.View")
+	err := s.db.View(fn)
+	endSpan(span, err)
+	return err
+}
+
+// update runs fn in a read-write Bolt transaction traced as a child span of ctx.
+func (s *metastoreServer) update(ctx context.Context, fn func(tx *bolt.Tx) error) error {
+	_, span := tracer.Start(ctx, "bolt.Update")
+	err := s.db.Update(fn)
+	endSpan(span, err)
+	return err
+}
+
+// tracedStream counts messages sent over a list stream
+type tracedStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent int
+}
+
+func (s *tracedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *tracedStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+	}
+	return err
+}
+
+// tracingStreamInterceptor adds a span covering results of server-streaming (list) calls.
+func tracingStreamInterceptor(srv interface{}, stream grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream || !info.IsServerStream {
+		return handler(srv, stream)
+	}
+	ctx, span := tracer.Start(stream.Context(), path.Base(info.FullMethod)+".stream")
+	s := &tracedStream{ServerStream: stream, ctx: ctx}
+	err := handler(srv, s)
+	span.SetAttributes(attribute.Int("hms.messages_sent", s.sent))
+	endSpan(span, err)
+	return err
+}
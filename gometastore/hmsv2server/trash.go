@@ -0,0 +1,434 @@
+// Trash
+//
+// Dropped tables and databases are moved to the TRASH bucket of their catalog and
+// kept there for the retention period, so they can be restored with Undrop:
+//
+//   TRASH+
+//        + <id>                  ID of the dropped table or database
+//             INFO   -> { DroppedObject }
+//             OBJECT -> { Table } or { Database }
+//             PARTS+             Partitions of the table
+//             SCHEMAS+           Schema history of the table
+//             DATA+              DB/<id> bucket of the database
+//
+// Objects in trash are not in the ID index and are not exported. The purger removes
+// them after they expire. Drops with purge set or with zero retention remove objects
+// immediately.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	trashHdr      = "TRASH"
+	trashInfoKey  = "INFO"
+	trashObjKey   = "OBJECT"
+	trashPartsHdr = "PARTS"
+	trashDataHdr  = "DATA"
+)
+
+// copyBucket copies keys, nested buckets and sequences of src to dst
+func copyBucket(dst, src *bolt.Bucket) error {
+	if err := dst.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return dst.Put(copyBytes(k), copyBytes(v))
+		}
+		child, err := dst.CreateBucket(copyBytes(k))
+		if err != nil {
+			return err
+		}
+		return copyBucket(child, src.Bucket(k))
+	})
+}
+
+// copyNestedBucket copies bucket src, if it exists, to a new bucket of dst
+func copyNestedBucket(dst *bolt.Bucket, name string, src *bolt.Bucket) error {
+	if src == nil {
+		return nil
+	}
+	b, err := dst.CreateBucket([]byte(name))
+	if err != nil {
+		return err
+	}
+	return copyBucket(b, src)
+}
+
+// newDroppedObject returns trash record of the object dropped now
+func (s *metastoreServer) newDroppedObject(c context.Context, kind pb.DroppedObject_Kind,
+	id *pb.Id, dbID *pb.Id) *pb.DroppedObject {
+	now := nowMillis()
+	return &pb.DroppedObject{
+		Kind:       kind,
+		Id:         id,
+		DbId:       dbID,
+		DropTime:   now,
+		ExpireTime: now + int64(s.trashRetention/time.Millisecond),
+		DroppedBy:  principalFromContext(c),
+	}
+}
+
+// createTrashEntry creates trash bucket of the dropped object
+func createTrashEntry(catBucket *bolt.Bucket, info *pb.DroppedObject,
+	object []byte) (*bolt.Bucket, error) {
+	trash, err := catBucket.CreateBucketIfNotExists([]byte(trashHdr))
+	if err != nil {
+		return nil, err
+	}
+	key := []byte(info.Id.Id)
+	// Leftover of an object with the same ID is replaced
+	if err = trash.DeleteBucket(key); err != nil && err != bolt.ErrBucketNotFound {
+		return nil, err
+	}
+	entry, err := trash.CreateBucket(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	if err = entry.Put([]byte(trashInfoKey), data); err != nil {
+		return nil, err
+	}
+	return entry, entry.Put([]byte(trashObjKey), copyBytes(object))
+}
+
+// trashTable copies the table with its partitions and schema history to trash
+func trashTable(catBucket *bolt.Bucket, dbBucket *bolt.Bucket, info *pb.DroppedObject,
+	table []byte, partBucket *bolt.Bucket) error {
+	entry, err := createTrashEntry(catBucket, info, table)
+	if err != nil {
+		return err
+	}
+	if err = copyNestedBucket(entry, trashPartsHdr, partBucket); err != nil {
+		return err
+	}
+	return copyNestedBucket(entry, schemasHdr, getSchemaBucket(dbBucket, []byte(info.Id.Id)))
+}
+
+// trashDatabase copies the database with all its tables to trash
+func trashDatabase(catBucket *bolt.Bucket, info *pb.DroppedObject, database []byte,
+	dbBucket *bolt.Bucket) error {
+	entry, err := createTrashEntry(catBucket, info, database)
+	if err != nil {
+		return err
+	}
+	return copyNestedBucket(entry, trashDataHdr, dbBucket)
+}
+
+// getDroppedObject returns trash record of the entry
+func getDroppedObject(entry *bolt.Bucket) (*pb.DroppedObject, error) {
+	info := new(pb.DroppedObject)
+	if err := proto.Unmarshal(entry.Get([]byte(trashInfoKey)), info); err != nil {
+		return nil, err
+	}
+	if info.Id == nil || info.Id.Id == "" {
+		return nil, fmt.Errorf("missing ID")
+	}
+	return info, nil
+}
+
+// restoreTable moves the table from trash entry to its database. The database is
+// found by ID, so the table is not restored into another database which took the name.
+func restoreTable(tx *bolt.Tx, catalog string, catBucket *bolt.Bucket, entry *bolt.Bucket,
+	info *pb.DroppedObject, newName string) error {
+	dbName, dbID := info.GetDbId().GetName(), []byte(info.GetDbId().GetId())
+	if len(dbID) == 0 {
+		return fmt.Errorf("missing database ID of dropped table %s", info.Id.Id)
+	}
+	_, idMap, _, err := getDatabaseID(tx, catalog, &pb.Id{Id: string(dbID)})
+	if err != nil {
+		return err
+	}
+	if idMap.Get(dbID) == nil {
+		return notFoundError(fmt.Sprintf("database %s of dropped table %s does not exist",
+			dbName, info.Id.Id))
+	}
+	dbBucket, err := getDatabaseBucket(tx, catalog, &pb.Id{Name: dbName, Id: string(dbID)})
+	if err != nil {
+		return err
+	}
+	var table pb.Table
+	if err = proto.Unmarshal(entry.Get([]byte(trashObjKey)), &table); err != nil {
+		return fmt.Errorf("can't decode dropped table %s: %v", info.Id.Id, err)
+	}
+	if newName != "" {
+		table.Id.Name = newName
+	}
+	tableName, tableID := table.Id.Name, []byte(info.Id.Id)
+	byNameBucket := dbBucket.Bucket([]byte(bynameHdr))
+	byIDBucket := dbBucket.Bucket([]byte(byIDHdr))
+	tablesBucket := dbBucket.Bucket([]byte(tblsHdr))
+	if byNameBucket == nil || byIDBucket == nil || tablesBucket == nil {
+		return fmt.Errorf("corrupt catalog %s/%s: missing table buckets", catalog, dbName)
+	}
+	if byNameBucket.Get([]byte(tableName)) != nil {
+		return conflictError(fmt.Sprintf("table %s:%s.%s already exists", catalog, dbName, tableName))
+	}
+	idx, err := getIDIndex(catBucket)
+	if err != nil {
+		return err
+	}
+	if idx.Get(tableID) != nil || byIDBucket.Get(tableID) != nil ||
+		tablesBucket.Bucket(tableID) != nil {
+		return conflictError(fmt.Sprintf("object with ID %s already exists", tableID))
+	}
+	data, err := proto.Marshal(&table)
+	if err != nil {
+		return err
+	}
+	if err = byIDBucket.Put(tableID, data); err != nil {
+		return err
+	}
+	if err = byNameBucket.Put([]byte(tableName), tableID); err != nil {
+		return err
+	}
+	partBucket, err := tablesBucket.CreateBucket(tableID)
+	if err != nil {
+		return err
+	}
+	if parts := entry.Bucket([]byte(trashPartsHdr)); parts != nil {
+		if err = copyBucket(partBucket, parts); err != nil {
+			return err
+		}
+	}
+	if history := entry.Bucket([]byte(schemasHdr)); history != nil {
+		if err = dropTableSchemas(dbBucket, tableID); err != nil {
+			return err
+		}
+		schemas, err := dbBucket.CreateBucketIfNotExists([]byte(schemasHdr))
+		if err != nil {
+			return err
+		}
+		if err = copyNestedBucket(schemas, string(tableID), history); err != nil {
+			return err
+		}
+	}
+	return indexTable(idx, dbID, tableID, partBucket)
+}
+
+// restoreDatabase moves the database from trash entry to its catalog
+func restoreDatabase(catBucket *bolt.Bucket, entry *bolt.Bucket, info *pb.DroppedObject,
+	newName string) error {
+	var database pb.Database
+	if err := proto.Unmarshal(entry.Get([]byte(trashObjKey)), &database); err != nil {
+		return fmt.Errorf("can't decode dropped database %s: %v", info.Id.Id, err)
+	}
+	if newName != "" {
+		database.Id.Name = newName
+	}
+	dbName, dbID := database.Id.Name, []byte(info.Id.Id)
+	nameMap := catBucket.Bucket([]byte(bynameHdr))
+	idMap := catBucket.Bucket([]byte(byIDHdr))
+	dbBuckets := catBucket.Bucket([]byte(dbHdr))
+	if nameMap == nil || idMap == nil || dbBuckets == nil {
+		return fmt.Errorf("corrupt catalog: missing database buckets")
+	}
+	if nameMap.Get([]byte(dbName)) != nil {
+		return conflictError(fmt.Sprintf("database %s already exists", dbName))
+	}
+	idx, err := getIDIndex(catBucket)
+	if err != nil {
+		return err
+	}
+	if idx.Get(dbID) != nil || idMap.Get(dbID) != nil || dbBuckets.Bucket(dbID) != nil {
+		return conflictError(fmt.Sprintf("object with ID %s already exists", dbID))
+	}
+	data, err := proto.Marshal(&database)
+	if err != nil {
+		return err
+	}
+	if err = idMap.Put(dbID, data); err != nil {
+		return err
+	}
+	if err = nameMap.Put([]byte(dbName), dbID); err != nil {
+		return err
+	}
+	dbBucket, err := dbBuckets.CreateBucket(dbID)
+	if err != nil {
+		return err
+	}
+	if dbData := entry.Bucket([]byte(trashDataHdr)); dbData != nil {
+		if err = copyBucket(dbBucket, dbData); err != nil {
+			return err
+		}
+	}
+	return indexDatabase(idx, dbID, dbBucket)
+}
+
+func (s *metastoreServer) ListDropped(req *pb.ListDroppedRequest,
+	stream pb.Metastore_ListDroppedServer) error {
+	logger := requestLogger(stream.Context())
+	logger.Debug("ListDropped: ", req)
+	catalog := req.Catalog
+	if catalog == "" {
+		return fmt.Errorf("missing catalog")
+	}
+	dbName, dbID := req.GetDbId().GetName(), req.GetDbId().GetId()
+
+	err := s.view(stream.Context(), func(tx *bolt.Tx) error {
+		catBucket, err := getCatalogBucket(tx, catalog)
+		if err != nil {
+			return err
+		}
+		trash := catBucket.Bucket([]byte(trashHdr))
+		if trash == nil {
+			return nil
+		}
+		return trash.ForEach(func(k, v []byte) error {
+			if v != nil {
+				return nil
+			}
+			info, err := getDroppedObject(trash.Bucket(k))
+			if err != nil {
+				logger.WithError(err).Warnf("can't decode dropped object %s", k)
+				return nil
+			}
+			// The database is matched by ID if it is given
+			if dbID != "" && (info.Kind != pb.DroppedObject_DROPPED_TABLE ||
+				info.GetDbId().GetId() != dbID) {
+				return nil
+			}
+			if dbID == "" && dbName != "" && (info.Kind != pb.DroppedObject_DROPPED_TABLE ||
+				info.GetDbId().GetName() != dbName) {
+				return nil
+			}
+			if err := stream.Send(info); err != nil {
+				logger.WithError(err).Warn("failed to send dropped object")
+				return err
+			}
+			return nil
+		})
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to list dropped objects")
+		return streamError(err)
+	}
+	return nil
+}
+
+func (s *metastoreServer) Undrop(c context.Context,
+	req *pb.UndropRequest) (*pb.RequestStatus, error) {
+	logger := requestLogger(c)
+	logger.Debug("Undrop: ", req)
+	catalog := req.Catalog
+	if catalog == "" {
+		return nil, fmt.Errorf("missing catalog")
+	}
+	if req.Id == "" {
+		return nil, fmt.Errorf("missing ID")
+	}
+
+	err := s.update(c, func(tx *bolt.Tx) error {
+		catBucket, err := getCatalogBucket(tx, catalog)
+		if err != nil {
+			return err
+		}
+		trash := catBucket.Bucket([]byte(trashHdr))
+		var entry *bolt.Bucket
+		if trash != nil {
+			entry = trash.Bucket([]byte(req.Id))
+		}
+		if entry == nil {
+			return notFoundError(fmt.Sprintf("dropped object %s does not exist", req.Id))
+		}
+		info, err := getDroppedObject(entry)
+		if err != nil {
+			return fmt.Errorf("can't decode dropped object %s: %v", req.Id, err)
+		}
+		switch info.Kind {
+		case pb.DroppedObject_DROPPED_TABLE:
+			err = restoreTable(tx, catalog, catBucket, entry, info, req.NewName)
+		case pb.DroppedObject_DROPPED_DATABASE:
+			err = restoreDatabase(catBucket, entry, info, req.NewName)
+		default:
+			err = fmt.Errorf("unknown kind of dropped object %s: %v", req.Id, info.Kind)
+		}
+		if err != nil {
+			return err
+		}
+		return trash.DeleteBucket([]byte(req.Id))
+	})
+
+	if err != nil {
+		logger.WithError(err).Warn("failed to undrop")
+		return errorStatus(err), nil
+	}
+
+	return &pb.RequestStatus{Status: pb.RequestStatus_STATUS_OK}, nil
+}
+
+// purgeTrash removes expired objects from trash of all catalogs and returns the
+// number of removed objects. Entries with trash records which can't be decoded are
+// kept and logged, they can be removed only by dropping the catalog.
+func purgeTrash(db *bolt.DB) (int, error) {
+	now := nowMillis()
+	purged := 0
+	err := db.Update(func(tx *bolt.Tx) error {
+		return forEachCatalog(tx, func(name []byte, catBucket *bolt.Bucket) error {
+			trash := catBucket.Bucket([]byte(trashHdr))
+			if trash == nil {
+				return nil
+			}
+			// Buckets can't be deleted while iterating
+			var expired [][]byte
+			trash.ForEach(func(k, v []byte) error {
+				if v != nil {
+					return nil
+				}
+				info, err := getDroppedObject(trash.Bucket(k))
+				if err != nil {
+					log.WithFields(log.Fields{
+						"catalog": string(name),
+						"id":      string(k),
+					}).Warn("can't decode trash entry: ", err)
+					return nil
+				}
+				if info.ExpireTime <= now {
+					expired = append(expired, copyBytes(k))
+				}
+				return nil
+			})
+			for _, k := range expired {
+				if err := trash.DeleteBucket(k); err != nil {
+					return err
+				}
+				purged++
+			}
+			return nil
+		})
+	})
+	return purged, err
+}
+
+// runTrashPurger purges expired objects periodically until stop is closed.
+func runTrashPurger(db *bolt.DB, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			purged, err := purgeTrash(db)
+			if err != nil {
+				log.WithError(err).Error("failed to purge trash")
+			} else if purged != 0 {
+				log.WithField("objects", purged).Info("purged trash")
+			}
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	pb "github.com/akolb1/hmsv2api/gometastore/protobuf"
+	"github.com/boltdb/bolt"
+	"google.golang.org/grpc"
+)
+
+const (
+	testCatalog = "cat"
+	testDb      = "db"
+	testTbl     = "tbl"
+)
+
+// newTestServer returns server with a catalog, a database and a partitioned table
+func newTestServer(t *testing.T, retention time.Duration) (*metastoreServer, *pb.Table) {
+	t.Helper()
+	s := newServer(openTestDB(t), retention)
+	c := context.Background()
+	catResp, err := s.CreateCatalog(c, &pb.CreateCatalogRequest{Catalog: &pb.Catalog{Name: testCatalog}})
+	checkStatus(t, catResp.GetStatus(), err)
+	dbResp, err := s.CreateDabatase(c, &pb.CreateDatabaseRequest{
+		Catalog:  testCatalog,
+		Database: &pb.Database{Id: &pb.Id{Name: testDb}},
+	})
+	checkStatus(t, dbResp.GetStatus(), err)
+	table := createTestTable(t, s, testTbl)
+	partResp, err := s.AddPartition(c, &pb.AddPartitionRequest{
+		Catalog:   testCatalog,
+		DbId:      &pb.Id{Name: testDb},
+		TableId:   &pb.Id{Name: testTbl},
+		Partition: &pb.Partition{Values: []string{"1"}},
+	})
+	checkStatus(t, partResp.GetStatus(), err)
+	return s, table
+}
+
+func createTestTable(t *testing.T, s *metastoreServer, name string) *pb.Table {
+	t.Helper()
+	resp, err := s.CreateTable(context.Background(), &pb.CreateTableRequest{
+		Catalog: testCatalog,
+		DbId:    &pb.Id{Name: testDb},
+		Table: &pb.Table{
+			Id:            &pb.Id{Name: name},
+			Sd:            &pb.StorageDescriptor{Cols: testColumns("a", "string")},
+			PartitionKeys: testColumns("hr", "int"),
+		},
+	})
+	checkStatus(t, resp.GetStatus(), err)
+	return resp.Table
+}
+
+func checkStatus(t *testing.T, status *pb.RequestStatus, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.GetStatus() != pb.RequestStatus_STATUS_OK {
+		t.Fatalf("unexpected status %v: %s", status.GetStatus(), status.GetError())
+	}
+}
+
+func dropTestTable(t *testing.T, s *metastoreServer, purge bool) {
+	t.Helper()
+	status, err := s.DropTable(context.Background(), &pb.DropTableRequest{
+		Catalog: testCatalog,
+		DbId:    &pb.Id{Name: testDb},
+		Id:      &pb.Id{Name: testTbl},
+		Purge:   purge,
+	})
+	checkStatus(t, status, err)
+}
+
+// recreateTestDatabase drops the test database and creates a new one with its name
+func recreateTestDatabase(t *testing.T, s *metastoreServer) {
+	t.Helper()
+	c := context.Background()
+	status, err := s.DropDatabase(c, &pb.DropDatabaseRequest{Catalog: testCatalog, Id: &pb.Id{Name: testDb}})
+	checkStatus(t, status, err)
+	dbResp, err := s.CreateDabatase(c, &pb.CreateDatabaseRequest{
+		Catalog:  testCatalog,
+		Database: &pb.Database{Id: &pb.Id{Name: testDb}},
+	})
+	checkStatus(t, dbResp.GetStatus(), err)
+}
+
+// listDroppedStream collects objects sent by ListDropped
+type listDroppedStream struct {
+	grpc.ServerStream
+	objects []*pb.DroppedObject
+}
+
+func (s *listDroppedStream) Context() context.Context { return context.Background() }
+
+func (s *listDroppedStream) Send(object *pb.DroppedObject) error {
+	s.objects = append(s.objects, object)
+	return nil
+}
+
+// resolveStatus returns status of ResolveId for the object
+func resolveStatus(t *testing.T, s *metastoreServer, id string) pb.RequestStatus_Status {
+	t.Helper()
+	resp, err := s.ResolveId(context.Background(), &pb.ResolveIdRequest{Catalog: testCatalog, Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.GetStatus().GetStatus()
+}
+
+func TestUndropTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, s *metastoreServer, table *pb.Table)
+		newName string
+		want    pb.RequestStatus_Status
+		wantIn  string // name of the restored table
+	}{
+		{
+			name:    "restore",
+			prepare: func(t *testing.T, s *metastoreServer, table *pb.Table) { dropTestTable(t, s, false) },
+			want:    pb.RequestStatus_STATUS_OK,
+			wantIn:  testTbl,
+		},
+		{
+			name: "name taken",
+			prepare: func(t *testing.T, s *metastoreServer, table *pb.Table) {
+				dropTestTable(t, s, false)
+				createTestTable(t, s, testTbl)
+			},
+			want: pb.RequestStatus_STATUS_CONFLICT,
+		},
+		{
+			name: "restore under new name",
+			prepare: func(t *testing.T, s *metastoreServer, table *pb.Table) {
+				dropTestTable(t, s, false)
+				createTestTable(t, s, testTbl)
+			},
+			newName: "restored",
+			want:    pb.RequestStatus_STATUS_OK,
+			wantIn:  "restored",
+		},
+		{
+			name: "ID is live",
+			prepare: func(t *testing.T, s *metastoreServer, table *pb.Table) {
+				dropTestTable(t, s, false)
+				// An object with the same ID was imported after the drop
+				err := s.db.Update(func(tx *bolt.Tx) error {
+					idx, err := getCatalogIDIndex(tx, testCatalog)
+					if err != nil {
+						return err
+					}
+					return putIDLocation(idx, table.Id.Id, &idLocation{Kind: kindDatabase})
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			newName: "restored",
+			want:    pb.RequestStatus_STATUS_CONFLICT,
+		},
+		{
+			name: "database recreated",
+			prepare: func(t *testing.T, s *metastoreServer, table *pb.Table) {
+				dropTestTable(t, s, false)
+				recreateTestDatabase(t, s)
+			},
+			want: pb.RequestStatus_STATUS_NOTFOUND,
+		},
+		{
+			name:    "purged",
+			prepare: func(t *testing.T, s *metastoreServer, table *pb.Table) { dropTestTable(t, s, true) },
+			want:    pb.RequestStatus_STATUS_NOTFOUND,
+		},
+		{
+			name:    "not dropped",
+			prepare: func(t *testing.T, s *metastoreServer, table *pb.Table) {},
+			want:    pb.RequestStatus_STATUS_NOTFOUND,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, table := newTestServer(t, time.Hour)
+			tt.prepare(t, s, table)
+			status, err := s.Undrop(context.Background(), &pb.UndropRequest{
+				Catalog: testCatalog,
+				Id:      table.Id.Id,
+				NewName: tt.newName,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if status.Status != tt.want {
+				t.Fatalf("status %v (%s), want %v", status.Status, status.Error, tt.want)
+			}
+			if tt.wantIn == "" {
+				return
+			}
+			resp, err := s.GetTable(context.Background(), &pb.GetTableRequest{
+				Catalog: testCatalog,
+				DbId:    &pb.Id{Name: testDb},
+				Id:      &pb.Id{Name: tt.wantIn},
+			})
+			checkStatus(t, resp.GetStatus(), err)
+			if resp.Table.Id.Id != table.Id.Id {
+				t.Errorf("restored table has ID %s, want %s", resp.Table.Id.Id, table.Id.Id)
+			}
+			// Partitions are restored and indexed
+			resolved, err := s.ResolveId(context.Background(),
+				&pb.ResolveIdRequest{Catalog: testCatalog, Id: table.Id.Id})
+			checkStatus(t, resolved.GetStatus(), err)
+			if resolved.TableId.GetName() != tt.wantIn {
+				t.Errorf("ID resolved to table %s, want %s", resolved.TableId.GetName(), tt.wantIn)
+			}
+			names, err := s.GetPartitionNames(context.Background(), &pb.GetPartitionNamesRequest{
+				Catalog: testCatalog,
+				DbId:    &pb.Id{Name: testDb},
+				TableId: &pb.Id{Name: tt.wantIn},
+			})
+			checkStatus(t, names.GetStatus(), err)
+			if len(names.Names) != 1 || names.Names[0] != "hr=1" {
+				t.Errorf("restored partitions %q, want [hr=1]", names.Names)
+			}
+		})
+	}
+}
+
+func TestUndropDatabase(t *testing.T) {
+	s, table := newTestServer(t, time.Hour)
+	c := context.Background()
+	dbResp, err := s.GetDatabase(c, &pb.GetDatabaseRequest{Catalog: testCatalog, Id: &pb.Id{Name: testDb}})
+	checkStatus(t, dbResp.GetStatus(), err)
+	dbID := dbResp.Database.Id.Id
+
+	status, err := s.DropDatabase(c, &pb.DropDatabaseRequest{Catalog: testCatalog, Id: &pb.Id{Name: testDb}})
+	checkStatus(t, status, err)
+	for _, id := range []string{dbID, table.Id.Id} {
+		if got := resolveStatus(t, s, id); got != pb.RequestStatus_STATUS_NOTFOUND {
+			t.Errorf("dropped object %s resolves with %v", id, got)
+		}
+	}
+
+	status, err = s.Undrop(c, &pb.UndropRequest{Catalog: testCatalog, Id: dbID})
+	checkStatus(t, status, err)
+	for _, id := range []string{dbID, table.Id.Id} {
+		if got := resolveStatus(t, s, id); got != pb.RequestStatus_STATUS_OK {
+			t.Errorf("restored object %s resolves with %v", id, got)
+		}
+	}
+	tblResp, err := s.GetTable(c, &pb.GetTableRequest{
+		Catalog: testCatalog,
+		DbId:    &pb.Id{Name: testDb},
+		Id:      &pb.Id{Name: testTbl},
+	})
+	checkStatus(t, tblResp.GetStatus(), err)
+}
+
+func TestListDropped(t *testing.T) {
+	s, table := newTestServer(t, time.Hour)
+	c := context.Background()
+	dbResp, err := s.GetDatabase(c, &pb.GetDatabaseRequest{Catalog: testCatalog, Id: &pb.Id{Name: testDb}})
+	checkStatus(t, dbResp.GetStatus(), err)
+	oldDbID := dbResp.Database.Id.Id
+	dropTestTable(t, s, false)
+	recreateTestDatabase(t, s)
+	dbResp, err = s.GetDatabase(c, &pb.GetDatabaseRequest{Catalog: testCatalog, Id: &pb.Id{Name: testDb}})
+	checkStatus(t, dbResp.GetStatus(), err)
+
+	tests := []struct {
+		name string
+		dbID *pb.Id
+		want []string
+	}{
+		{"all", nil, []string{table.Id.Id, oldDbID}},
+		{"by name", &pb.Id{Name: testDb}, []string{table.Id.Id}},
+		{"by old ID", &pb.Id{Name: testDb, Id: oldDbID}, []string{table.Id.Id}},
+		{"by new ID", &pb.Id{Name: testDb, Id: dbResp.Database.Id.Id}, nil},
+	}
+	for _, tt := range tests {
+		stream := &listDroppedStream{}
+		err := s.ListDropped(&pb.ListDroppedRequest{Catalog: testCatalog, DbId: tt.dbID}, stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, object := range stream.objects {
+			got = append(got, object.Id.Id)
+		}
+		sort.Strings(got)
+		sort.Strings(tt.want)
+		if !equalValues(got, tt.want) {
+			t.Errorf("%s: listed %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention time.Duration
+		garbage   bool // add undecodable trash entry
+		purged    int
+	}{
+		{"not expired", time.Hour, false, 0},
+		{"expired", time.Millisecond, false, 1},
+		{"undecodable entry is kept", time.Millisecond, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, table := newTestServer(t, tt.retention)
+			dropTestTable(t, s, false)
+			if tt.garbage {
+				err := s.db.Update(func(tx *bolt.Tx) error {
+					catBucket, err := getCatalogBucket(tx, testCatalog)
+					if err != nil {
+						return err
+					}
+					entry, err := catBucket.Bucket([]byte(trashHdr)).CreateBucket([]byte("garbage"))
+					if err != nil {
+						return err
+					}
+					return entry.Put([]byte(trashInfoKey), []byte("garbage"))
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			purged, err := purgeTrash(s.db)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if purged != tt.purged {
+				t.Errorf("purged %d objects, want %d", purged, tt.purged)
+			}
+			status, err := s.Undrop(context.Background(),
+				&pb.UndropRequest{Catalog: testCatalog, Id: table.Id.Id})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := pb.RequestStatus_STATUS_OK
+			if tt.purged != 0 {
+				want = pb.RequestStatus_STATUS_NOTFOUND
+			}
+			if status.Status != want {
+				t.Errorf("undrop status %v, want %v", status.Status, want)
+			}
+			if tt.garbage {
+				s.db.View(func(tx *bolt.Tx) error {
+					catBucket, _ := getCatalogBucket(tx, testCatalog)
+					if catBucket.Bucket([]byte(trashHdr)).Bucket([]byte("garbage")) == nil {
+						t.Error("undecodable trash entry is purged")
+					}
+					return nil
+				})
+			}
+		})
+	}
+}
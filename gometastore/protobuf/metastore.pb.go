@@ -10,6 +10,13 @@ It is generated from these files:
 It has these top-level messages:
 	RequestStatus
 	Id
+	Catalog
+	CreateCatalogRequest
+	GetCatalogRequest
+	GetCatalogResponse
+	ListCatalogsRequest
+	AlterCatalogRequest
+	DropCatalogRequest
 	Database
 	CreateDatabaseRequest
 	AlterDatabaseRequest
@@ -22,11 +29,22 @@ It has these top-level messages:
 	Order
 	StorageDescriptor
 	Table
+	TableSchema
 	CreateTableRequest
 	GetTableRequest
 	GetTableResponse
 	ListTablesRequest
 	DropTableRequest
+	DroppedObject
+	ListDroppedRequest
+	UndropRequest
+	AlterTableRequest
+	SchemaIncompatibility
+	AddColumnsRequest
+	ReplaceColumnsRequest
+	ChangeColumnRequest
+	DropColumnRequest
+	AlterTableResponse
 	Partition
 	AddPartitionRequest
 	AddPartitionResponse
@@ -35,6 +53,12 @@ It has these top-level messages:
 	ListPartitionsRequest
 	PartitionValues
 	DropPartitionsRequest
+	GetPartitionNamesRequest
+	GetPartitionNamesResponse
+	ResolveIdRequest
+	ResolveIdResponse
+	BackupRequest
+	BackupChunk
 */
 package metastore
 
@@ -234,6 +258,7 @@ const (
 	RequestStatus_STATUS_CONFLICT     RequestStatus_Status = 3
 	RequestStatus_STATUS_BUSY         RequestStatus_Status = 4
 	RequestStatus_STATUS_INTERNAL_ERR RequestStatus_Status = 5
+	RequestStatus_STATUS_INVALID      RequestStatus_Status = 6
 )
 
 var RequestStatus_Status_name = map[int32]string{
@@ -243,6 +268,7 @@ var RequestStatus_Status_name = map[int32]string{
 	3: "STATUS_CONFLICT",
 	4: "STATUS_BUSY",
 	5: "STATUS_INTERNAL_ERR",
+	6: "STATUS_INVALID",
 }
 var RequestStatus_Status_value = map[string]int32{
 	"STATUS_OK":           0,
@@ -251,6 +277,7 @@ var RequestStatus_Status_value = map[string]int32{
 	"STATUS_CONFLICT":     3,
 	"STATUS_BUSY":         4,
 	"STATUS_INTERNAL_ERR": 5,
+	"STATUS_INVALID":      6,
 }
 
 func (x RequestStatus_Status) String() string {
@@ -258,6 +285,80 @@ func (x RequestStatus_Status) String() string {
 }
 func (RequestStatus_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
 
+type DroppedObject_Kind int32
+
+const (
+	DroppedObject_DROPPED_TABLE    DroppedObject_Kind = 0
+	DroppedObject_DROPPED_DATABASE DroppedObject_Kind = 1
+)
+
+var DroppedObject_Kind_name = map[int32]string{
+	0: "DROPPED_TABLE",
+	1: "DROPPED_DATABASE",
+}
+var DroppedObject_Kind_value = map[string]int32{
+	"DROPPED_TABLE":    0,
+	"DROPPED_DATABASE": 1,
+}
+
+func (x DroppedObject_Kind) String() string {
+	return proto.EnumName(DroppedObject_Kind_name, int32(x))
+}
+func (DroppedObject_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{27, 0} }
+
+type SchemaIncompatibility_Kind int32
+
+const (
+	SchemaIncompatibility_INCOMPATIBLE_DROPPED SchemaIncompatibility_Kind = 0
+	SchemaIncompatibility_INCOMPATIBLE_MOVED   SchemaIncompatibility_Kind = 1
+	SchemaIncompatibility_INCOMPATIBLE_TYPE    SchemaIncompatibility_Kind = 2
+)
+
+var SchemaIncompatibility_Kind_name = map[int32]string{
+	0: "INCOMPATIBLE_DROPPED",
+	1: "INCOMPATIBLE_MOVED",
+	2: "INCOMPATIBLE_TYPE",
+}
+var SchemaIncompatibility_Kind_value = map[string]int32{
+	"INCOMPATIBLE_DROPPED": 0,
+	"INCOMPATIBLE_MOVED":   1,
+	"INCOMPATIBLE_TYPE":    2,
+}
+
+func (x SchemaIncompatibility_Kind) String() string {
+	return proto.EnumName(SchemaIncompatibility_Kind_name, int32(x))
+}
+func (SchemaIncompatibility_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{31, 0}
+}
+
+type ResolveIdResponse_Kind int32
+
+const (
+	ResolveIdResponse_KIND_UNKNOWN   ResolveIdResponse_Kind = 0
+	ResolveIdResponse_KIND_DATABASE  ResolveIdResponse_Kind = 1
+	ResolveIdResponse_KIND_TABLE     ResolveIdResponse_Kind = 2
+	ResolveIdResponse_KIND_PARTITION ResolveIdResponse_Kind = 3
+)
+
+var ResolveIdResponse_Kind_name = map[int32]string{
+	0: "KIND_UNKNOWN",
+	1: "KIND_DATABASE",
+	2: "KIND_TABLE",
+	3: "KIND_PARTITION",
+}
+var ResolveIdResponse_Kind_value = map[string]int32{
+	"KIND_UNKNOWN":   0,
+	"KIND_DATABASE":  1,
+	"KIND_TABLE":     2,
+	"KIND_PARTITION": 3,
+}
+
+func (x ResolveIdResponse_Kind) String() string {
+	return proto.EnumName(ResolveIdResponse_Kind_name, int32(x))
+}
+func (ResolveIdResponse_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{48, 0} }
+
 // General status for results.
 //
 // All non-streaming requests should return RequestStatus.
@@ -316,6 +417,202 @@ func (m *Id) GetId() string {
 	return ""
 }
 
+// Catalog is a container for databases.
+//
+// Catalogs are identified by name only and can't be renamed.
+type Catalog struct {
+	Name        string            `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Description string            `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Location    string            `protobuf:"bytes,3,opt,name=location" json:"location,omitempty"`
+	Parameters  map[string]string `protobuf:"bytes,4,rep,name=parameters" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *Catalog) Reset()                    { *m = Catalog{} }
+func (m *Catalog) String() string            { return proto.CompactTextString(m) }
+func (*Catalog) ProtoMessage()               {}
+func (*Catalog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Catalog) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Catalog) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Catalog) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *Catalog) GetParameters() map[string]string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+// Create a new catalog
+type CreateCatalogRequest struct {
+	Catalog *Catalog `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	Cookie  string   `protobuf:"bytes,2,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *CreateCatalogRequest) Reset()                    { *m = CreateCatalogRequest{} }
+func (m *CreateCatalogRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateCatalogRequest) ProtoMessage()               {}
+func (*CreateCatalogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *CreateCatalogRequest) GetCatalog() *Catalog {
+	if m != nil {
+		return m.Catalog
+	}
+	return nil
+}
+
+func (m *CreateCatalogRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Request to get catalog by its name
+type GetCatalogRequest struct {
+	Name   string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Cookie string `protobuf:"bytes,2,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *GetCatalogRequest) Reset()                    { *m = GetCatalogRequest{} }
+func (m *GetCatalogRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCatalogRequest) ProtoMessage()               {}
+func (*GetCatalogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *GetCatalogRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetCatalogRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Result of GetCatalog request
+type GetCatalogResponse struct {
+	Catalog *Catalog       `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	Status  *RequestStatus `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *GetCatalogResponse) Reset()                    { *m = GetCatalogResponse{} }
+func (m *GetCatalogResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetCatalogResponse) ProtoMessage()               {}
+func (*GetCatalogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *GetCatalogResponse) GetCatalog() *Catalog {
+	if m != nil {
+		return m.Catalog
+	}
+	return nil
+}
+
+func (m *GetCatalogResponse) GetStatus() *RequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// Request to get list of catalogs
+type ListCatalogsRequest struct {
+	Cookie string `protobuf:"bytes,1,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *ListCatalogsRequest) Reset()                    { *m = ListCatalogsRequest{} }
+func (m *ListCatalogsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListCatalogsRequest) ProtoMessage()               {}
+func (*ListCatalogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ListCatalogsRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Alter catalog.
+//
+// Description and location are changed if they are not empty, parameters are replaced.
+type AlterCatalogRequest struct {
+	Name    string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Catalog *Catalog `protobuf:"bytes,2,opt,name=catalog" json:"catalog,omitempty"`
+	Cookie  string   `protobuf:"bytes,3,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *AlterCatalogRequest) Reset()                    { *m = AlterCatalogRequest{} }
+func (m *AlterCatalogRequest) String() string            { return proto.CompactTextString(m) }
+func (*AlterCatalogRequest) ProtoMessage()               {}
+func (*AlterCatalogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *AlterCatalogRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlterCatalogRequest) GetCatalog() *Catalog {
+	if m != nil {
+		return m.Catalog
+	}
+	return nil
+}
+
+func (m *AlterCatalogRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Request to drop a catalog.
+// Only catalogs without databases can be dropped.
+type DropCatalogRequest struct {
+	Name   string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Cookie string `protobuf:"bytes,2,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *DropCatalogRequest) Reset()                    { *m = DropCatalogRequest{} }
+func (m *DropCatalogRequest) String() string            { return proto.CompactTextString(m) }
+func (*DropCatalogRequest) ProtoMessage()               {}
+func (*DropCatalogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *DropCatalogRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DropCatalogRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
 // Database is a container for tables.
 //
 // Database object has two sets of parameters:
@@ -332,18 +629,28 @@ func (m *Id) GetId() string {
 // Original Metastore Database object also had owner information.
 // These can be represented using system parameters if needed since the current
 // metastore service does not interpret Owner info.
+//
+// Databases, tables and partitions carry timestamps in milliseconds since epoch and the
+// principals which created and last modified them. Creation and modification info is
+// maintained by the server, last access time is set on creation and can be changed
+// by the client. Objects created before timestamps were introduced have zero times.
 type Database struct {
 	Id               *Id               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	SeqId            uint64            `protobuf:"varint,2,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
 	Location         string            `protobuf:"bytes,3,opt,name=location" json:"location,omitempty"`
 	Parameters       map[string]string `protobuf:"bytes,4,rep,name=parameters" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	SystemParameters map[string]string `protobuf:"bytes,5,rep,name=system_parameters,json=systemParameters" json:"system_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreateTime       int64             `protobuf:"varint,6,opt,name=create_time,json=createTime" json:"create_time,omitempty"`
+	LastModifiedTime int64             `protobuf:"varint,7,opt,name=last_modified_time,json=lastModifiedTime" json:"last_modified_time,omitempty"`
+	LastAccessTime   int64             `protobuf:"varint,8,opt,name=last_access_time,json=lastAccessTime" json:"last_access_time,omitempty"`
+	CreatedBy        string            `protobuf:"bytes,9,opt,name=created_by,json=createdBy" json:"created_by,omitempty"`
+	ModifiedBy       string            `protobuf:"bytes,10,opt,name=modified_by,json=modifiedBy" json:"modified_by,omitempty"`
 }
 
 func (m *Database) Reset()                    { *m = Database{} }
 func (m *Database) String() string            { return proto.CompactTextString(m) }
 func (*Database) ProtoMessage()               {}
-func (*Database) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*Database) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *Database) GetId() *Id {
 	if m != nil {
@@ -380,6 +687,41 @@ func (m *Database) GetSystemParameters() map[string]string {
 	return nil
 }
 
+func (m *Database) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *Database) GetLastModifiedTime() int64 {
+	if m != nil {
+		return m.LastModifiedTime
+	}
+	return 0
+}
+
+func (m *Database) GetLastAccessTime() int64 {
+	if m != nil {
+		return m.LastAccessTime
+	}
+	return 0
+}
+
+func (m *Database) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *Database) GetModifiedBy() string {
+	if m != nil {
+		return m.ModifiedBy
+	}
+	return ""
+}
+
 // Create a new database.
 //
 // If database.Id.id is empty, it will be assigned a unique ID
@@ -393,7 +735,7 @@ type CreateDatabaseRequest struct {
 func (m *CreateDatabaseRequest) Reset()                    { *m = CreateDatabaseRequest{} }
 func (m *CreateDatabaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateDatabaseRequest) ProtoMessage()               {}
-func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *CreateDatabaseRequest) GetCatalog() string {
 	if m != nil {
@@ -427,7 +769,7 @@ type AlterDatabaseRequest struct {
 func (m *AlterDatabaseRequest) Reset()                    { *m = AlterDatabaseRequest{} }
 func (m *AlterDatabaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*AlterDatabaseRequest) ProtoMessage()               {}
-func (*AlterDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*AlterDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *AlterDatabaseRequest) GetCatalog() string {
 	if m != nil {
@@ -470,7 +812,7 @@ type GetDatabaseRequest struct {
 func (m *GetDatabaseRequest) Reset()                    { *m = GetDatabaseRequest{} }
 func (m *GetDatabaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetDatabaseRequest) ProtoMessage()               {}
-func (*GetDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*GetDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *GetDatabaseRequest) GetCatalog() string {
 	if m != nil {
@@ -506,7 +848,7 @@ type GetDatabaseResponse struct {
 func (m *GetDatabaseResponse) Reset()                    { *m = GetDatabaseResponse{} }
 func (m *GetDatabaseResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetDatabaseResponse) ProtoMessage()               {}
-func (*GetDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*GetDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *GetDatabaseResponse) GetDatabase() *Database {
 	if m != nil {
@@ -536,13 +878,14 @@ type ListDatabasesRequest struct {
 	//   - id
 	//   - id.name
 	//   - parameters
-	Fields []string `protobuf:"bytes,5,rep,name=fields" json:"fields,omitempty"`
+	Fields        []string `protobuf:"bytes,5,rep,name=fields" json:"fields,omitempty"`
+	ModifiedSince int64    `protobuf:"varint,6,opt,name=modified_since,json=modifiedSince" json:"modified_since,omitempty"`
 }
 
 func (m *ListDatabasesRequest) Reset()                    { *m = ListDatabasesRequest{} }
 func (m *ListDatabasesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListDatabasesRequest) ProtoMessage()               {}
-func (*ListDatabasesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *ListDatabasesRequest) GetCatalog() string {
 	if m != nil {
@@ -579,6 +922,13 @@ func (m *ListDatabasesRequest) GetFields() []string {
 	return nil
 }
 
+func (m *ListDatabasesRequest) GetModifiedSince() int64 {
+	if m != nil {
+		return m.ModifiedSince
+	}
+	return 0
+}
+
 // Request to drop a database.
 // Dropping a database also drops all objects contained in the database.
 // TODO: Add flag to prohibit dropping non-empty databases
@@ -586,12 +936,13 @@ type DropDatabaseRequest struct {
 	Catalog string `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
 	Id      *Id    `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
 	Cookie  string `protobuf:"bytes,3,opt,name=cookie" json:"cookie,omitempty"`
+	Purge   bool   `protobuf:"varint,4,opt,name=purge" json:"purge,omitempty"`
 }
 
 func (m *DropDatabaseRequest) Reset()                    { *m = DropDatabaseRequest{} }
 func (m *DropDatabaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*DropDatabaseRequest) ProtoMessage()               {}
-func (*DropDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *DropDatabaseRequest) GetCatalog() string {
 	if m != nil {
@@ -614,6 +965,13 @@ func (m *DropDatabaseRequest) GetCookie() string {
 	return ""
 }
 
+func (m *DropDatabaseRequest) GetPurge() bool {
+	if m != nil {
+		return m.Purge
+	}
+	return false
+}
+
 // FieldSchema defines name and type for each column.
 type FieldSchema struct {
 	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -624,7 +982,7 @@ type FieldSchema struct {
 func (m *FieldSchema) Reset()                    { *m = FieldSchema{} }
 func (m *FieldSchema) String() string            { return proto.CompactTextString(m) }
 func (*FieldSchema) ProtoMessage()               {}
-func (*FieldSchema) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*FieldSchema) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *FieldSchema) GetName() string {
 	if m != nil {
@@ -659,7 +1017,7 @@ type SerDeInfo struct {
 func (m *SerDeInfo) Reset()                    { *m = SerDeInfo{} }
 func (m *SerDeInfo) String() string            { return proto.CompactTextString(m) }
 func (*SerDeInfo) ProtoMessage()               {}
-func (*SerDeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*SerDeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *SerDeInfo) GetType() SerdeType {
 	if m != nil {
@@ -698,7 +1056,7 @@ type Order struct {
 func (m *Order) Reset()                    { *m = Order{} }
 func (m *Order) String() string            { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()               {}
-func (*Order) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*Order) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *Order) GetCol() string {
 	if m != nil {
@@ -732,7 +1090,7 @@ type StorageDescriptor struct {
 func (m *StorageDescriptor) Reset()                    { *m = StorageDescriptor{} }
 func (m *StorageDescriptor) String() string            { return proto.CompactTextString(m) }
 func (*StorageDescriptor) ProtoMessage()               {}
-func (*StorageDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*StorageDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *StorageDescriptor) GetCols() []*FieldSchema {
 	if m != nil {
@@ -821,12 +1179,18 @@ type Table struct {
 	Parameters       map[string]string  `protobuf:"bytes,7,rep,name=parameters" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	SystemParameters map[string]string  `protobuf:"bytes,8,rep,name=system_parameters,json=systemParameters" json:"system_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Location         string             `protobuf:"bytes,9,opt,name=location" json:"location,omitempty"`
+	CreateTime       int64              `protobuf:"varint,10,opt,name=create_time,json=createTime" json:"create_time,omitempty"`
+	LastModifiedTime int64              `protobuf:"varint,11,opt,name=last_modified_time,json=lastModifiedTime" json:"last_modified_time,omitempty"`
+	LastAccessTime   int64              `protobuf:"varint,12,opt,name=last_access_time,json=lastAccessTime" json:"last_access_time,omitempty"`
+	CreatedBy        string             `protobuf:"bytes,13,opt,name=created_by,json=createdBy" json:"created_by,omitempty"`
+	ModifiedBy       string             `protobuf:"bytes,14,opt,name=modified_by,json=modifiedBy" json:"modified_by,omitempty"`
+	SchemaVersion    int32              `protobuf:"varint,15,opt,name=schema_version,json=schemaVersion" json:"schema_version,omitempty"`
 }
 
 func (m *Table) Reset()                    { *m = Table{} }
 func (m *Table) String() string            { return proto.CompactTextString(m) }
 func (*Table) ProtoMessage()               {}
-func (*Table) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*Table) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *Table) GetId() *Id {
 	if m != nil {
@@ -884,6 +1248,92 @@ func (m *Table) GetLocation() string {
 	return ""
 }
 
+func (m *Table) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *Table) GetLastModifiedTime() int64 {
+	if m != nil {
+		return m.LastModifiedTime
+	}
+	return 0
+}
+
+func (m *Table) GetLastAccessTime() int64 {
+	if m != nil {
+		return m.LastAccessTime
+	}
+	return 0
+}
+
+func (m *Table) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *Table) GetModifiedBy() string {
+	if m != nil {
+		return m.ModifiedBy
+	}
+	return ""
+}
+
+func (m *Table) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+// Version of the table schema.
+//
+// Tables keep history of their columns, every change of columns creates a new
+// version. Partitions record the schema version they were written with.
+type TableSchema struct {
+	Version    int32          `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	Cols       []*FieldSchema `protobuf:"bytes,2,rep,name=cols" json:"cols,omitempty"`
+	CreateTime int64          `protobuf:"varint,3,opt,name=create_time,json=createTime" json:"create_time,omitempty"`
+	CreatedBy  string         `protobuf:"bytes,4,opt,name=created_by,json=createdBy" json:"created_by,omitempty"`
+}
+
+func (m *TableSchema) Reset()                    { *m = TableSchema{} }
+func (m *TableSchema) String() string            { return proto.CompactTextString(m) }
+func (*TableSchema) ProtoMessage()               {}
+func (*TableSchema) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *TableSchema) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TableSchema) GetCols() []*FieldSchema {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
+func (m *TableSchema) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *TableSchema) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
 // Create a new table.
 type CreateTableRequest struct {
 	Catalog string `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
@@ -895,7 +1345,7 @@ type CreateTableRequest struct {
 func (m *CreateTableRequest) Reset()                    { *m = CreateTableRequest{} }
 func (m *CreateTableRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateTableRequest) ProtoMessage()               {}
-func (*CreateTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*CreateTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *CreateTableRequest) GetCatalog() string {
 	if m != nil {
@@ -936,7 +1386,7 @@ type GetTableRequest struct {
 func (m *GetTableRequest) Reset()                    { *m = GetTableRequest{} }
 func (m *GetTableRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTableRequest) ProtoMessage()               {}
-func (*GetTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*GetTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *GetTableRequest) GetCatalog() string {
 	if m != nil {
@@ -974,7 +1424,7 @@ type GetTableResponse struct {
 func (m *GetTableResponse) Reset()                    { *m = GetTableResponse{} }
 func (m *GetTableResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTableResponse) ProtoMessage()               {}
-func (*GetTableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*GetTableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *GetTableResponse) GetTable() *Table {
 	if m != nil {
@@ -1002,13 +1452,14 @@ type ListTablesRequest struct {
 	//   - location: table location
 	//   - parameters: table user parameters
 	//   - partkeys: table partition keys
-	Fields []string `protobuf:"bytes,4,rep,name=fields" json:"fields,omitempty"`
+	Fields        []string `protobuf:"bytes,4,rep,name=fields" json:"fields,omitempty"`
+	ModifiedSince int64    `protobuf:"varint,5,opt,name=modified_since,json=modifiedSince" json:"modified_since,omitempty"`
 }
 
 func (m *ListTablesRequest) Reset()                    { *m = ListTablesRequest{} }
 func (m *ListTablesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListTablesRequest) ProtoMessage()               {}
-func (*ListTablesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*ListTablesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *ListTablesRequest) GetCatalog() string {
 	if m != nil {
@@ -1038,6 +1489,13 @@ func (m *ListTablesRequest) GetFields() []string {
 	return nil
 }
 
+func (m *ListTablesRequest) GetModifiedSince() int64 {
+	if m != nil {
+		return m.ModifiedSince
+	}
+	return 0
+}
+
 // Request to drop a table.
 // Dropping a table also drops all objects contained in the table
 // TODO: Add flag to prohibit dropping of non-empty table
@@ -1046,12 +1504,13 @@ type DropTableRequest struct {
 	DbId    *Id    `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
 	Id      *Id    `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
 	Cookie  string `protobuf:"bytes,4,opt,name=cookie" json:"cookie,omitempty"`
+	Purge   bool   `protobuf:"varint,5,opt,name=purge" json:"purge,omitempty"`
 }
 
 func (m *DropTableRequest) Reset()                    { *m = DropTableRequest{} }
 func (m *DropTableRequest) String() string            { return proto.CompactTextString(m) }
 func (*DropTableRequest) ProtoMessage()               {}
-func (*DropTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*DropTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *DropTableRequest) GetCatalog() string {
 	if m != nil {
@@ -1081,366 +1540,1338 @@ func (m *DropTableRequest) GetCookie() string {
 	return ""
 }
 
-// Partition
-type Partition struct {
-	Id         *Id                `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	SeqId      uint64             `protobuf:"varint,2,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
-	Values     []string           `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
-	Sd         *StorageDescriptor `protobuf:"bytes,4,opt,name=sd" json:"sd,omitempty"`
-	Parameters map[string]string  `protobuf:"bytes,5,rep,name=parameters" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Location   string             `protobuf:"bytes,6,opt,name=location" json:"location,omitempty"`
-	Table      *Table             `protobuf:"bytes,7,opt,name=table" json:"table,omitempty"`
+func (m *DropTableRequest) GetPurge() bool {
+	if m != nil {
+		return m.Purge
+	}
+	return false
 }
 
-func (m *Partition) Reset()                    { *m = Partition{} }
-func (m *Partition) String() string            { return proto.CompactTextString(m) }
-func (*Partition) ProtoMessage()               {}
-func (*Partition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+// Table or database moved to trash by DropTable or DropDatabase.
+//
+// Dropped objects are kept until expire_time and can be restored with Undrop.
+type DroppedObject struct {
+	Kind       DroppedObject_Kind `protobuf:"varint,1,opt,name=kind,enum=metastore.DroppedObject_Kind" json:"kind,omitempty"`
+	Id         *Id                `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	DbId       *Id                `protobuf:"bytes,3,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	DropTime   int64              `protobuf:"varint,4,opt,name=drop_time,json=dropTime" json:"drop_time,omitempty"`
+	ExpireTime int64              `protobuf:"varint,5,opt,name=expire_time,json=expireTime" json:"expire_time,omitempty"`
+	DroppedBy  string             `protobuf:"bytes,6,opt,name=dropped_by,json=droppedBy" json:"dropped_by,omitempty"`
+}
+
+func (m *DroppedObject) Reset()                    { *m = DroppedObject{} }
+func (m *DroppedObject) String() string            { return proto.CompactTextString(m) }
+func (*DroppedObject) ProtoMessage()               {}
+func (*DroppedObject) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *DroppedObject) GetKind() DroppedObject_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return DroppedObject_DROPPED_TABLE
+}
 
-func (m *Partition) GetId() *Id {
+func (m *DroppedObject) GetId() *Id {
 	if m != nil {
 		return m.Id
 	}
 	return nil
 }
 
-func (m *Partition) GetSeqId() uint64 {
+func (m *DroppedObject) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *DroppedObject) GetDropTime() int64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
+func (m *DroppedObject) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *DroppedObject) GetDroppedBy() string {
+	if m != nil {
+		return m.DroppedBy
+	}
+	return ""
+}
+
+// Request to list dropped objects of the catalog.
+type ListDroppedRequest struct {
+	Catalog string `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId    *Id    `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	Cookie  string `protobuf:"bytes,3,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *ListDroppedRequest) Reset()                    { *m = ListDroppedRequest{} }
+func (m *ListDroppedRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDroppedRequest) ProtoMessage()               {}
+func (*ListDroppedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *ListDroppedRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *ListDroppedRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *ListDroppedRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Request to restore a dropped object.
+//
+// Tables are restored into the database they were dropped from, which must still
+// exist; a database dropped and created again under the same name is a different one.
+// Objects are restored under their old name unless new_name is set; restoring fails
+// with STATUS_CONFLICT if the name is taken.
+type UndropRequest struct {
+	Catalog string `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	NewName string `protobuf:"bytes,3,opt,name=new_name,json=newName" json:"new_name,omitempty"`
+	Cookie  string `protobuf:"bytes,4,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *UndropRequest) Reset()                    { *m = UndropRequest{} }
+func (m *UndropRequest) String() string            { return proto.CompactTextString(m) }
+func (*UndropRequest) ProtoMessage()               {}
+func (*UndropRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *UndropRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *UndropRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UndropRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *UndropRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Alter table.
+//
+// Fields listed in update_mask are changed: "sd", "location", "last_access_time",
+// "table_type" and "parameters". Without update_mask fields which are set (non-zero)
+// are changed, so resetting table type or clearing parameters needs the mask.
+// Partition keys can't be changed. Column changes are checked against the compatibility
+// mode of the table, set by the "schema.compatibility" parameter:
+//   - none: any change is allowed (default)
+//   - backward: readers using the new columns can read existing data. Columns can't
+//     be dropped or moved and column types can only be widened.
+//   - forward: readers using the old columns can read new data. Columns can't be
+//     dropped or moved and column types can only be narrowed.
+//   - full: both backward and forward. Column types can't be changed.
+// New columns can be added after existing ones in every mode. The mode of the table
+// before the change applies, so a change of the mode must be a separate request.
+type AlterTableRequest struct {
+	Catalog    string   `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId       *Id      `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	Id         *Id      `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	Table      *Table   `protobuf:"bytes,4,opt,name=table" json:"table,omitempty"`
+	Cookie     string   `protobuf:"bytes,5,opt,name=cookie" json:"cookie,omitempty"`
+	UpdateMask []string `protobuf:"bytes,6,rep,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
+}
+
+func (m *AlterTableRequest) Reset()                    { *m = AlterTableRequest{} }
+func (m *AlterTableRequest) String() string            { return proto.CompactTextString(m) }
+func (*AlterTableRequest) ProtoMessage()               {}
+func (*AlterTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *AlterTableRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *AlterTableRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *AlterTableRequest) GetId() *Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *AlterTableRequest) GetTable() *Table {
+	if m != nil {
+		return m.Table
+	}
+	return nil
+}
+
+func (m *AlterTableRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+func (m *AlterTableRequest) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// Column change which breaks schema compatibility of the table
+type SchemaIncompatibility struct {
+	Kind    SchemaIncompatibility_Kind `protobuf:"varint,1,opt,name=kind,enum=metastore.SchemaIncompatibility_Kind" json:"kind,omitempty"`
+	Column  string                     `protobuf:"bytes,2,opt,name=column" json:"column,omitempty"`
+	OldType string                     `protobuf:"bytes,3,opt,name=old_type,json=oldType" json:"old_type,omitempty"`
+	NewType string                     `protobuf:"bytes,4,opt,name=new_type,json=newType" json:"new_type,omitempty"`
+	Message string                     `protobuf:"bytes,5,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *SchemaIncompatibility) Reset()                    { *m = SchemaIncompatibility{} }
+func (m *SchemaIncompatibility) String() string            { return proto.CompactTextString(m) }
+func (*SchemaIncompatibility) ProtoMessage()               {}
+func (*SchemaIncompatibility) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *SchemaIncompatibility) GetKind() SchemaIncompatibility_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return SchemaIncompatibility_INCOMPATIBLE_DROPPED
+}
+
+func (m *SchemaIncompatibility) GetColumn() string {
+	if m != nil {
+		return m.Column
+	}
+	return ""
+}
+
+func (m *SchemaIncompatibility) GetOldType() string {
+	if m != nil {
+		return m.OldType
+	}
+	return ""
+}
+
+func (m *SchemaIncompatibility) GetNewType() string {
+	if m != nil {
+		return m.NewType
+	}
+	return ""
+}
+
+func (m *SchemaIncompatibility) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// Add columns to a table.
+//
+// Column requests change table columns like AlterTable does, including compatibility
+// checks. Partitions keep their columns unless cascade is set, in which case columns
+// of all partitions are replaced with the new table columns.
+type AddColumnsRequest struct {
+	Catalog string         `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId    *Id            `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	Id      *Id            `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	Cols    []*FieldSchema `protobuf:"bytes,4,rep,name=cols" json:"cols,omitempty"`
+	Cascade bool           `protobuf:"varint,5,opt,name=cascade" json:"cascade,omitempty"`
+	Cookie  string         `protobuf:"bytes,6,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *AddColumnsRequest) Reset()                    { *m = AddColumnsRequest{} }
+func (m *AddColumnsRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddColumnsRequest) ProtoMessage()               {}
+func (*AddColumnsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *AddColumnsRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *AddColumnsRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *AddColumnsRequest) GetId() *Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *AddColumnsRequest) GetCols() []*FieldSchema {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
+func (m *AddColumnsRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+func (m *AddColumnsRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Replace all columns of a table.
+type ReplaceColumnsRequest struct {
+	Catalog string         `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId    *Id            `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	Id      *Id            `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	Cols    []*FieldSchema `protobuf:"bytes,4,rep,name=cols" json:"cols,omitempty"`
+	Cascade bool           `protobuf:"varint,5,opt,name=cascade" json:"cascade,omitempty"`
+	Cookie  string         `protobuf:"bytes,6,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *ReplaceColumnsRequest) Reset()                    { *m = ReplaceColumnsRequest{} }
+func (m *ReplaceColumnsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplaceColumnsRequest) ProtoMessage()               {}
+func (*ReplaceColumnsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *ReplaceColumnsRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *ReplaceColumnsRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *ReplaceColumnsRequest) GetId() *Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReplaceColumnsRequest) GetCols() []*FieldSchema {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
+func (m *ReplaceColumnsRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+func (m *ReplaceColumnsRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Change a single column of a table.
+//
+// Empty name and type of the new column keep the current ones, the comment is always
+// replaced. The column stays in its position unless first or after is set.
+type ChangeColumnRequest struct {
+	Catalog string       `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId    *Id          `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	Id      *Id          `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	Name    string       `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	Column  *FieldSchema `protobuf:"bytes,5,opt,name=column" json:"column,omitempty"`
+	First   bool         `protobuf:"varint,6,opt,name=first" json:"first,omitempty"`
+	After   string       `protobuf:"bytes,7,opt,name=after" json:"after,omitempty"`
+	Cascade bool         `protobuf:"varint,8,opt,name=cascade" json:"cascade,omitempty"`
+	Cookie  string       `protobuf:"bytes,9,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *ChangeColumnRequest) Reset()                    { *m = ChangeColumnRequest{} }
+func (m *ChangeColumnRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangeColumnRequest) ProtoMessage()               {}
+func (*ChangeColumnRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ChangeColumnRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *ChangeColumnRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *ChangeColumnRequest) GetId() *Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ChangeColumnRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ChangeColumnRequest) GetColumn() *FieldSchema {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+func (m *ChangeColumnRequest) GetFirst() bool {
+	if m != nil {
+		return m.First
+	}
+	return false
+}
+
+func (m *ChangeColumnRequest) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func (m *ChangeColumnRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+func (m *ChangeColumnRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Drop a single column of a table.
+type DropColumnRequest struct {
+	Catalog string `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId    *Id    `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	Id      *Id    `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	Cascade bool   `protobuf:"varint,5,opt,name=cascade" json:"cascade,omitempty"`
+	Cookie  string `protobuf:"bytes,6,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *DropColumnRequest) Reset()                    { *m = DropColumnRequest{} }
+func (m *DropColumnRequest) String() string            { return proto.CompactTextString(m) }
+func (*DropColumnRequest) ProtoMessage()               {}
+func (*DropColumnRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *DropColumnRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *DropColumnRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *DropColumnRequest) GetId() *Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *DropColumnRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DropColumnRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+func (m *DropColumnRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+// Result of AlterTable and column requests. Incompatible column changes are rejected
+// with STATUS_CONFLICT and listed in incompatibilities.
+type AlterTableResponse struct {
+	Table             *Table                   `protobuf:"bytes,1,opt,name=table" json:"table,omitempty"`
+	Status            *RequestStatus           `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Incompatibilities []*SchemaIncompatibility `protobuf:"bytes,3,rep,name=incompatibilities" json:"incompatibilities,omitempty"`
+}
+
+func (m *AlterTableResponse) Reset()                    { *m = AlterTableResponse{} }
+func (m *AlterTableResponse) String() string            { return proto.CompactTextString(m) }
+func (*AlterTableResponse) ProtoMessage()               {}
+func (*AlterTableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *AlterTableResponse) GetTable() *Table {
+	if m != nil {
+		return m.Table
+	}
+	return nil
+}
+
+func (m *AlterTableResponse) GetStatus() *RequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *AlterTableResponse) GetIncompatibilities() []*SchemaIncompatibility {
+	if m != nil {
+		return m.Incompatibilities
+	}
+	return nil
+}
+
+// Partition
+type Partition struct {
+	Id                *Id                `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	SeqId             uint64             `protobuf:"varint,2,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
+	Values            []string           `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+	Sd                *StorageDescriptor `protobuf:"bytes,4,opt,name=sd" json:"sd,omitempty"`
+	Parameters        map[string]string  `protobuf:"bytes,5,rep,name=parameters" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Location          string             `protobuf:"bytes,6,opt,name=location" json:"location,omitempty"`
+	Table             *Table             `protobuf:"bytes,7,opt,name=table" json:"table,omitempty"`
+	CreateTime        int64              `protobuf:"varint,8,opt,name=create_time,json=createTime" json:"create_time,omitempty"`
+	LastModifiedTime  int64              `protobuf:"varint,9,opt,name=last_modified_time,json=lastModifiedTime" json:"last_modified_time,omitempty"`
+	LastAccessTime    int64              `protobuf:"varint,10,opt,name=last_access_time,json=lastAccessTime" json:"last_access_time,omitempty"`
+	CreatedBy         string             `protobuf:"bytes,11,opt,name=created_by,json=createdBy" json:"created_by,omitempty"`
+	ModifiedBy        string             `protobuf:"bytes,12,opt,name=modified_by,json=modifiedBy" json:"modified_by,omitempty"`
+	InheritedSdFields []string           `protobuf:"bytes,13,rep,name=inherited_sd_fields,json=inheritedSdFields" json:"inherited_sd_fields,omitempty"`
+	SchemaVersion     int32              `protobuf:"varint,14,opt,name=schema_version,json=schemaVersion" json:"schema_version,omitempty"`
+}
+
+func (m *Partition) Reset()                    { *m = Partition{} }
+func (m *Partition) String() string            { return proto.CompactTextString(m) }
+func (*Partition) ProtoMessage()               {}
+func (*Partition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *Partition) GetId() *Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Partition) GetSeqId() uint64 {
 	if m != nil {
 		return m.SeqId
 	}
-	return 0
+	return 0
+}
+
+func (m *Partition) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *Partition) GetSd() *StorageDescriptor {
+	if m != nil {
+		return m.Sd
+	}
+	return nil
+}
+
+func (m *Partition) GetParameters() map[string]string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *Partition) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *Partition) GetTable() *Table {
+	if m != nil {
+		return m.Table
+	}
+	return nil
+}
+
+func (m *Partition) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *Partition) GetLastModifiedTime() int64 {
+	if m != nil {
+		return m.LastModifiedTime
+	}
+	return 0
+}
+
+func (m *Partition) GetLastAccessTime() int64 {
+	if m != nil {
+		return m.LastAccessTime
+	}
+	return 0
+}
+
+func (m *Partition) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *Partition) GetModifiedBy() string {
+	if m != nil {
+		return m.ModifiedBy
+	}
+	return ""
+}
+
+func (m *Partition) GetInheritedSdFields() []string {
+	if m != nil {
+		return m.InheritedSdFields
+	}
+	return nil
+}
+
+func (m *Partition) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+// Add a single partition to a table.
+//
+// Partition is described by list of "values" - one value per partition schema.
+// The number of values must match the number of table partition keys.
+// Each partition belongs to a table and each table belongs to a database
+type AddPartitionRequest struct {
+	Sequence  uint64     `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
+	Catalog   string     `protobuf:"bytes,2,opt,name=catalog" json:"catalog,omitempty"`
+	DbId      *Id        `protobuf:"bytes,3,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	TableId   *Id        `protobuf:"bytes,4,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
+	Partition *Partition `protobuf:"bytes,5,opt,name=partition" json:"partition,omitempty"`
+}
+
+func (m *AddPartitionRequest) Reset()                    { *m = AddPartitionRequest{} }
+func (m *AddPartitionRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddPartitionRequest) ProtoMessage()               {}
+func (*AddPartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *AddPartitionRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AddPartitionRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *AddPartitionRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *AddPartitionRequest) GetTableId() *Id {
+	if m != nil {
+		return m.TableId
+	}
+	return nil
+}
+
+func (m *AddPartitionRequest) GetPartition() *Partition {
+	if m != nil {
+		return m.Partition
+	}
+	return nil
+}
+
+// Response from AdddPartitionRequest matches sequence to the request.
+type AddPartitionResponse struct {
+	Sequence uint64         `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
+	Status   *RequestStatus `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *AddPartitionResponse) Reset()                    { *m = AddPartitionResponse{} }
+func (m *AddPartitionResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddPartitionResponse) ProtoMessage()               {}
+func (*AddPartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *AddPartitionResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AddPartitionResponse) GetStatus() *RequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// Get partition information.
+//
+// Partition is described by list of "values" - one value per partition schema.
+// There is no validation that values actually match partition schema
+type GetPartitionRequest struct {
+	Catalog         string   `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId            *Id      `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	TableId         *Id      `protobuf:"bytes,3,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
+	Values          []string `protobuf:"bytes,4,rep,name=values" json:"values,omitempty"`
+	Name            string   `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	EffectiveSchema bool     `protobuf:"varint,6,opt,name=effective_schema,json=effectiveSchema" json:"effective_schema,omitempty"`
+}
+
+func (m *GetPartitionRequest) Reset()                    { *m = GetPartitionRequest{} }
+func (m *GetPartitionRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetPartitionRequest) ProtoMessage()               {}
+func (*GetPartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *GetPartitionRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *GetPartitionRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *GetPartitionRequest) GetTableId() *Id {
+	if m != nil {
+		return m.TableId
+	}
+	return nil
 }
 
-func (m *Partition) GetValues() []string {
+func (m *GetPartitionRequest) GetValues() []string {
 	if m != nil {
 		return m.Values
 	}
 	return nil
 }
 
-func (m *Partition) GetSd() *StorageDescriptor {
+func (m *GetPartitionRequest) GetName() string {
 	if m != nil {
-		return m.Sd
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *Partition) GetParameters() map[string]string {
+func (m *GetPartitionRequest) GetEffectiveSchema() bool {
 	if m != nil {
-		return m.Parameters
+		return m.EffectiveSchema
+	}
+	return false
+}
+
+type GetPartitionResponse struct {
+	Partition *Partition     `protobuf:"bytes,1,opt,name=partition" json:"partition,omitempty"`
+	Status    *RequestStatus `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Schema    *TableSchema   `protobuf:"bytes,3,opt,name=schema" json:"schema,omitempty"`
+}
+
+func (m *GetPartitionResponse) Reset()                    { *m = GetPartitionResponse{} }
+func (m *GetPartitionResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetPartitionResponse) ProtoMessage()               {}
+func (*GetPartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *GetPartitionResponse) GetPartition() *Partition {
+	if m != nil {
+		return m.Partition
 	}
 	return nil
 }
 
-func (m *Partition) GetLocation() string {
+func (m *GetPartitionResponse) GetStatus() *RequestStatus {
 	if m != nil {
-		return m.Location
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-func (m *Partition) GetTable() *Table {
+func (m *GetPartitionResponse) GetSchema() *TableSchema {
 	if m != nil {
-		return m.Table
+		return m.Schema
 	}
 	return nil
 }
 
-// Add a single partition to a table.
+// Return all partitions in a table
 //
-// Partition is described by list of "values" - one value per partition schema.
-// There is no validation that values actually match partition schema
-// Each partition belongs to a table and each table belongs to a database
-type AddPartitionRequest struct {
-	Sequence  uint64     `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
-	Catalog   string     `protobuf:"bytes,2,opt,name=catalog" json:"catalog,omitempty"`
-	DbId      *Id        `protobuf:"bytes,3,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
-	TableId   *Id        `protobuf:"bytes,4,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
-	Partition *Partition `protobuf:"bytes,5,opt,name=partition" json:"partition,omitempty"`
+// Field selectors.
+//
+// If specified, only certain fields are sent. The following fields are supported:
+//   - location
+//   - values
+//   - parameters
+//   - sd
+//   - sd.parameters
+//   - sd.serdeinfo
+//   - sd.serdeinfo.parameters
+//   - table
+//
+// Partitions are returned in the order of partition values according to their types.
+// Ranges are specified by values of leading partition keys: from is inclusive, to is
+// exclusive and prefix selects partitions having these values. Ranges may be combined
+// with each other and with explicit values or names.
+//
+// Compact partitions don't have Sd fields which are the same as in the table Sd, they
+// are listed in inherited_sd_fields instead. The table is sent with the first partition.
+type ListPartitionsRequest struct {
+	Catalog       string             `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId          *Id                `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	TableId       *Id                `protobuf:"bytes,3,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
+	Cookie        string             `protobuf:"bytes,4,opt,name=cookie" json:"cookie,omitempty"`
+	Fields        []string           `protobuf:"bytes,5,rep,name=fields" json:"fields,omitempty"`
+	Values        []*PartitionValues `protobuf:"bytes,6,rep,name=values" json:"values,omitempty"`
+	Exclude       []string           `protobuf:"bytes,7,rep,name=exclude" json:"exclude,omitempty"`
+	ModifiedSince int64              `protobuf:"varint,8,opt,name=modified_since,json=modifiedSince" json:"modified_since,omitempty"`
+	Names         []string           `protobuf:"bytes,9,rep,name=names" json:"names,omitempty"`
+	From          []string           `protobuf:"bytes,10,rep,name=from" json:"from,omitempty"`
+	To            []string           `protobuf:"bytes,11,rep,name=to" json:"to,omitempty"`
+	Prefix        []string           `protobuf:"bytes,12,rep,name=prefix" json:"prefix,omitempty"`
+	Descending    bool               `protobuf:"varint,13,opt,name=descending" json:"descending,omitempty"`
+	Compact       bool               `protobuf:"varint,14,opt,name=compact" json:"compact,omitempty"`
 }
 
-func (m *AddPartitionRequest) Reset()                    { *m = AddPartitionRequest{} }
-func (m *AddPartitionRequest) String() string            { return proto.CompactTextString(m) }
-func (*AddPartitionRequest) ProtoMessage()               {}
-func (*AddPartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
-
-func (m *AddPartitionRequest) GetSequence() uint64 {
-	if m != nil {
-		return m.Sequence
-	}
-	return 0
-}
+func (m *ListPartitionsRequest) Reset()                    { *m = ListPartitionsRequest{} }
+func (m *ListPartitionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPartitionsRequest) ProtoMessage()               {}
+func (*ListPartitionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
-func (m *AddPartitionRequest) GetCatalog() string {
+func (m *ListPartitionsRequest) GetCatalog() string {
 	if m != nil {
 		return m.Catalog
 	}
 	return ""
 }
 
-func (m *AddPartitionRequest) GetDbId() *Id {
+func (m *ListPartitionsRequest) GetDbId() *Id {
 	if m != nil {
 		return m.DbId
 	}
 	return nil
 }
 
-func (m *AddPartitionRequest) GetTableId() *Id {
+func (m *ListPartitionsRequest) GetTableId() *Id {
 	if m != nil {
 		return m.TableId
 	}
 	return nil
 }
 
-func (m *AddPartitionRequest) GetPartition() *Partition {
+func (m *ListPartitionsRequest) GetCookie() string {
 	if m != nil {
-		return m.Partition
+		return m.Cookie
+	}
+	return ""
+}
+
+func (m *ListPartitionsRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
 	}
 	return nil
 }
 
-// Response from AdddPartitionRequest matches sequence to the request.
-type AddPartitionResponse struct {
-	Sequence uint64         `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
-	Status   *RequestStatus `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+func (m *ListPartitionsRequest) GetValues() []*PartitionValues {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
 
-func (m *AddPartitionResponse) Reset()                    { *m = AddPartitionResponse{} }
-func (m *AddPartitionResponse) String() string            { return proto.CompactTextString(m) }
-func (*AddPartitionResponse) ProtoMessage()               {}
-func (*AddPartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (m *ListPartitionsRequest) GetExclude() []string {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
 
-func (m *AddPartitionResponse) GetSequence() uint64 {
+func (m *ListPartitionsRequest) GetModifiedSince() int64 {
 	if m != nil {
-		return m.Sequence
+		return m.ModifiedSince
 	}
 	return 0
 }
 
-func (m *AddPartitionResponse) GetStatus() *RequestStatus {
+func (m *ListPartitionsRequest) GetNames() []string {
 	if m != nil {
-		return m.Status
+		return m.Names
 	}
 	return nil
 }
 
-// Get partition information.
+func (m *ListPartitionsRequest) GetFrom() []string {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ListPartitionsRequest) GetTo() []string {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *ListPartitionsRequest) GetPrefix() []string {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *ListPartitionsRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *ListPartitionsRequest) GetCompact() bool {
+	if m != nil {
+		return m.Compact
+	}
+	return false
+}
+
+type PartitionValues struct {
+	Value []string `protobuf:"bytes,1,rep,name=value" json:"value,omitempty"`
+}
+
+func (m *PartitionValues) Reset()                    { *m = PartitionValues{} }
+func (m *PartitionValues) String() string            { return proto.CompactTextString(m) }
+func (*PartitionValues) ProtoMessage()               {}
+func (*PartitionValues) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *PartitionValues) GetValue() []string {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// Delete partition.
 //
-// Partition is described by list of "values" - one value per partition schema.
-// There is no validation that values actually match partition schema
-type GetPartitionRequest struct {
-	Catalog string   `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
-	DbId    *Id      `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
-	TableId *Id      `protobuf:"bytes,3,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
-	Values  []string `protobuf:"bytes,4,rep,name=values" json:"values,omitempty"`
+// Partition is described by list of "values" - one value per partition schema,
+// or by its name. All partitions with given values of leading partition keys are
+// dropped with "prefix".
+// TODO: have flag for specifying fields in the returned value
+type DropPartitionsRequest struct {
+	Catalog string             `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId    *Id                `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	TableId *Id                `protobuf:"bytes,3,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
+	Values  []*PartitionValues `protobuf:"bytes,4,rep,name=values" json:"values,omitempty"`
+	Cookie  string             `protobuf:"bytes,5,opt,name=cookie" json:"cookie,omitempty"`
+	Names   []string           `protobuf:"bytes,6,rep,name=names" json:"names,omitempty"`
+	Prefix  []string           `protobuf:"bytes,7,rep,name=prefix" json:"prefix,omitempty"`
 }
 
-func (m *GetPartitionRequest) Reset()                    { *m = GetPartitionRequest{} }
-func (m *GetPartitionRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetPartitionRequest) ProtoMessage()               {}
-func (*GetPartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *DropPartitionsRequest) Reset()                    { *m = DropPartitionsRequest{} }
+func (m *DropPartitionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*DropPartitionsRequest) ProtoMessage()               {}
+func (*DropPartitionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
-func (m *GetPartitionRequest) GetCatalog() string {
+func (m *DropPartitionsRequest) GetCatalog() string {
 	if m != nil {
 		return m.Catalog
 	}
 	return ""
 }
 
-func (m *GetPartitionRequest) GetDbId() *Id {
+func (m *DropPartitionsRequest) GetDbId() *Id {
 	if m != nil {
 		return m.DbId
 	}
 	return nil
 }
 
-func (m *GetPartitionRequest) GetTableId() *Id {
+func (m *DropPartitionsRequest) GetTableId() *Id {
 	if m != nil {
 		return m.TableId
 	}
 	return nil
 }
 
-func (m *GetPartitionRequest) GetValues() []string {
+func (m *DropPartitionsRequest) GetValues() []*PartitionValues {
 	if m != nil {
 		return m.Values
 	}
 	return nil
 }
 
-type GetPartitionResponse struct {
-	Partition *Partition     `protobuf:"bytes,1,opt,name=partition" json:"partition,omitempty"`
-	Status    *RequestStatus `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+func (m *DropPartitionsRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
 }
 
-func (m *GetPartitionResponse) Reset()                    { *m = GetPartitionResponse{} }
-func (m *GetPartitionResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetPartitionResponse) ProtoMessage()               {}
-func (*GetPartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (m *DropPartitionsRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
 
-func (m *GetPartitionResponse) GetPartition() *Partition {
+func (m *DropPartitionsRequest) GetPrefix() []string {
 	if m != nil {
-		return m.Partition
+		return m.Prefix
+	}
+	return nil
+}
+
+// Request for partition names of a table.
+//
+// Partition names have Hive form "key1=value1/key2=value2" with keys and values escaped
+// like Hive does for path names.
+type GetPartitionNamesRequest struct {
+	Catalog  string `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	DbId     *Id    `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	TableId  *Id    `protobuf:"bytes,3,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
+	Cookie   string `protobuf:"bytes,4,opt,name=cookie" json:"cookie,omitempty"`
+	MaxParts int32  `protobuf:"varint,5,opt,name=max_parts,json=maxParts" json:"max_parts,omitempty"`
+}
+
+func (m *GetPartitionNamesRequest) Reset()                    { *m = GetPartitionNamesRequest{} }
+func (m *GetPartitionNamesRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetPartitionNamesRequest) ProtoMessage()               {}
+func (*GetPartitionNamesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *GetPartitionNamesRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *GetPartitionNamesRequest) GetDbId() *Id {
+	if m != nil {
+		return m.DbId
+	}
+	return nil
+}
+
+func (m *GetPartitionNamesRequest) GetTableId() *Id {
+	if m != nil {
+		return m.TableId
+	}
+	return nil
+}
+
+func (m *GetPartitionNamesRequest) GetCookie() string {
+	if m != nil {
+		return m.Cookie
+	}
+	return ""
+}
+
+func (m *GetPartitionNamesRequest) GetMaxParts() int32 {
+	if m != nil {
+		return m.MaxParts
+	}
+	return 0
+}
+
+type GetPartitionNamesResponse struct {
+	Names  []string       `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+	Status *RequestStatus `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *GetPartitionNamesResponse) Reset()                    { *m = GetPartitionNamesResponse{} }
+func (m *GetPartitionNamesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetPartitionNamesResponse) ProtoMessage()               {}
+func (*GetPartitionNamesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *GetPartitionNamesResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *GetPartitionNamesResponse) GetStatus() *RequestStatus {
+	if m != nil {
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetPartitionResponse) GetStatus() *RequestStatus {
+// Request to find an object by its permanent ID
+type ResolveIdRequest struct {
+	Catalog string `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	Cookie  string `protobuf:"bytes,3,opt,name=cookie" json:"cookie,omitempty"`
+}
+
+func (m *ResolveIdRequest) Reset()                    { *m = ResolveIdRequest{} }
+func (m *ResolveIdRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResolveIdRequest) ProtoMessage()               {}
+func (*ResolveIdRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *ResolveIdRequest) GetCatalog() string {
+	if m != nil {
+		return m.Catalog
+	}
+	return ""
+}
+
+func (m *ResolveIdRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ResolveIdRequest) GetCookie() string {
 	if m != nil {
-		return m.Status
+		return m.Cookie
 	}
-	return nil
+	return ""
 }
 
-// Return all partitions in a table
-//
-// Field selectors.
+// Result of ResolveId request.
 //
-// If specified, only certain fields are sent. The following fields are supported:
-//   - location
-//   - values
-//   - parameters
-//   - sd
-//   - sd.parameters
-//   - sd.serdeinfo
-//   - sd.serdeinfo.parameters
-//   - table
-type ListPartitionsRequest struct {
-	Catalog string             `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
-	DbId    *Id                `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
-	TableId *Id                `protobuf:"bytes,3,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
-	Cookie  string             `protobuf:"bytes,4,opt,name=cookie" json:"cookie,omitempty"`
-	Fields  []string           `protobuf:"bytes,5,rep,name=fields" json:"fields,omitempty"`
-	Values  []*PartitionValues `protobuf:"bytes,6,rep,name=values" json:"values,omitempty"`
-	Exclude []string           `protobuf:"bytes,7,rep,name=exclude" json:"exclude,omitempty"`
-}
-
-func (m *ListPartitionsRequest) Reset()                    { *m = ListPartitionsRequest{} }
-func (m *ListPartitionsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListPartitionsRequest) ProtoMessage()               {}
-func (*ListPartitionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
-
-func (m *ListPartitionsRequest) GetCatalog() string {
+// The result has the kind of the object, its current path and the object itself.
+// Only the object of the matching kind is set.
+type ResolveIdResponse struct {
+	Kind      ResolveIdResponse_Kind `protobuf:"varint,1,opt,name=kind,enum=metastore.ResolveIdResponse_Kind" json:"kind,omitempty"`
+	DbId      *Id                    `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
+	TableId   *Id                    `protobuf:"bytes,3,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
+	Values    []string               `protobuf:"bytes,4,rep,name=values" json:"values,omitempty"`
+	Database  *Database              `protobuf:"bytes,5,opt,name=database" json:"database,omitempty"`
+	Table     *Table                 `protobuf:"bytes,6,opt,name=table" json:"table,omitempty"`
+	Partition *Partition             `protobuf:"bytes,7,opt,name=partition" json:"partition,omitempty"`
+	Status    *RequestStatus         `protobuf:"bytes,8,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *ResolveIdResponse) Reset()                    { *m = ResolveIdResponse{} }
+func (m *ResolveIdResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResolveIdResponse) ProtoMessage()               {}
+func (*ResolveIdResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *ResolveIdResponse) GetKind() ResolveIdResponse_Kind {
 	if m != nil {
-		return m.Catalog
+		return m.Kind
 	}
-	return ""
+	return ResolveIdResponse_KIND_UNKNOWN
 }
 
-func (m *ListPartitionsRequest) GetDbId() *Id {
+func (m *ResolveIdResponse) GetDbId() *Id {
 	if m != nil {
 		return m.DbId
 	}
 	return nil
 }
 
-func (m *ListPartitionsRequest) GetTableId() *Id {
+func (m *ResolveIdResponse) GetTableId() *Id {
 	if m != nil {
 		return m.TableId
 	}
 	return nil
 }
 
-func (m *ListPartitionsRequest) GetCookie() string {
+func (m *ResolveIdResponse) GetValues() []string {
 	if m != nil {
-		return m.Cookie
+		return m.Values
 	}
-	return ""
+	return nil
 }
 
-func (m *ListPartitionsRequest) GetFields() []string {
+func (m *ResolveIdResponse) GetDatabase() *Database {
 	if m != nil {
-		return m.Fields
+		return m.Database
 	}
 	return nil
 }
 
-func (m *ListPartitionsRequest) GetValues() []*PartitionValues {
+func (m *ResolveIdResponse) GetTable() *Table {
 	if m != nil {
-		return m.Values
+		return m.Table
 	}
 	return nil
 }
 
-func (m *ListPartitionsRequest) GetExclude() []string {
+func (m *ResolveIdResponse) GetPartition() *Partition {
 	if m != nil {
-		return m.Exclude
+		return m.Partition
 	}
 	return nil
 }
 
-type PartitionValues struct {
-	Value []string `protobuf:"bytes,1,rep,name=value" json:"value,omitempty"`
-}
-
-func (m *PartitionValues) Reset()                    { *m = PartitionValues{} }
-func (m *PartitionValues) String() string            { return proto.CompactTextString(m) }
-func (*PartitionValues) ProtoMessage()               {}
-func (*PartitionValues) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
-
-func (m *PartitionValues) GetValue() []string {
+func (m *ResolveIdResponse) GetStatus() *RequestStatus {
 	if m != nil {
-		return m.Value
+		return m.Status
 	}
 	return nil
 }
 
-// Delete partition.
-//
-// Partition is described by list of "values" - one value per partition schema.
-// There is no validation that values actually match partition schema
-// TODO: have flag for specifying fields in the returned value
-type DropPartitionsRequest struct {
-	Catalog string             `protobuf:"bytes,1,opt,name=catalog" json:"catalog,omitempty"`
-	DbId    *Id                `protobuf:"bytes,2,opt,name=db_id,json=dbId" json:"db_id,omitempty"`
-	TableId *Id                `protobuf:"bytes,3,opt,name=table_id,json=tableId" json:"table_id,omitempty"`
-	Values  []*PartitionValues `protobuf:"bytes,4,rep,name=values" json:"values,omitempty"`
-	Cookie  string             `protobuf:"bytes,5,opt,name=cookie" json:"cookie,omitempty"`
+// Request for database snapshot.
+type BackupRequest struct {
+	ChunkSize uint32 `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize" json:"chunk_size,omitempty"`
+	Cookie    string `protobuf:"bytes,2,opt,name=cookie" json:"cookie,omitempty"`
 }
 
-func (m *DropPartitionsRequest) Reset()                    { *m = DropPartitionsRequest{} }
-func (m *DropPartitionsRequest) String() string            { return proto.CompactTextString(m) }
-func (*DropPartitionsRequest) ProtoMessage()               {}
-func (*DropPartitionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
+func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()               {}
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
-func (m *DropPartitionsRequest) GetCatalog() string {
+func (m *BackupRequest) GetChunkSize() uint32 {
 	if m != nil {
-		return m.Catalog
+		return m.ChunkSize
 	}
-	return ""
+	return 0
 }
 
-func (m *DropPartitionsRequest) GetDbId() *Id {
+func (m *BackupRequest) GetCookie() string {
 	if m != nil {
-		return m.DbId
+		return m.Cookie
 	}
-	return nil
+	return ""
 }
 
-func (m *DropPartitionsRequest) GetTableId() *Id {
+// A piece of database snapshot.
+//
+// Chunks should be concatenated in the order they are received.
+// The first chunk also carries the total size and the transaction ID of the snapshot.
+type BackupChunk struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Size int64  `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	TxId uint64 `protobuf:"varint,3,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+}
+
+func (m *BackupChunk) Reset()                    { *m = BackupChunk{} }
+func (m *BackupChunk) String() string            { return proto.CompactTextString(m) }
+func (*BackupChunk) ProtoMessage()               {}
+func (*BackupChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *BackupChunk) GetData() []byte {
 	if m != nil {
-		return m.TableId
+		return m.Data
 	}
 	return nil
 }
 
-func (m *DropPartitionsRequest) GetValues() []*PartitionValues {
+func (m *BackupChunk) GetSize() int64 {
 	if m != nil {
-		return m.Values
+		return m.Size
 	}
-	return nil
+	return 0
 }
 
-func (m *DropPartitionsRequest) GetCookie() string {
+func (m *BackupChunk) GetTxId() uint64 {
 	if m != nil {
-		return m.Cookie
+		return m.TxId
 	}
-	return ""
+	return 0
 }
 
 func init() {
 	proto.RegisterType((*RequestStatus)(nil), "metastore.RequestStatus")
 	proto.RegisterType((*Id)(nil), "metastore.Id")
+	proto.RegisterType((*Catalog)(nil), "metastore.Catalog")
+	proto.RegisterType((*CreateCatalogRequest)(nil), "metastore.CreateCatalogRequest")
+	proto.RegisterType((*GetCatalogRequest)(nil), "metastore.GetCatalogRequest")
+	proto.RegisterType((*GetCatalogResponse)(nil), "metastore.GetCatalogResponse")
+	proto.RegisterType((*ListCatalogsRequest)(nil), "metastore.ListCatalogsRequest")
+	proto.RegisterType((*AlterCatalogRequest)(nil), "metastore.AlterCatalogRequest")
+	proto.RegisterType((*DropCatalogRequest)(nil), "metastore.DropCatalogRequest")
 	proto.RegisterType((*Database)(nil), "metastore.Database")
 	proto.RegisterType((*CreateDatabaseRequest)(nil), "metastore.CreateDatabaseRequest")
 	proto.RegisterType((*AlterDatabaseRequest)(nil), "metastore.AlterDatabaseRequest")
@@ -1453,11 +2884,22 @@ func init() {
 	proto.RegisterType((*Order)(nil), "metastore.Order")
 	proto.RegisterType((*StorageDescriptor)(nil), "metastore.StorageDescriptor")
 	proto.RegisterType((*Table)(nil), "metastore.Table")
+	proto.RegisterType((*TableSchema)(nil), "metastore.TableSchema")
 	proto.RegisterType((*CreateTableRequest)(nil), "metastore.CreateTableRequest")
 	proto.RegisterType((*GetTableRequest)(nil), "metastore.GetTableRequest")
 	proto.RegisterType((*GetTableResponse)(nil), "metastore.GetTableResponse")
 	proto.RegisterType((*ListTablesRequest)(nil), "metastore.ListTablesRequest")
 	proto.RegisterType((*DropTableRequest)(nil), "metastore.DropTableRequest")
+	proto.RegisterType((*DroppedObject)(nil), "metastore.DroppedObject")
+	proto.RegisterType((*ListDroppedRequest)(nil), "metastore.ListDroppedRequest")
+	proto.RegisterType((*UndropRequest)(nil), "metastore.UndropRequest")
+	proto.RegisterType((*AlterTableRequest)(nil), "metastore.AlterTableRequest")
+	proto.RegisterType((*SchemaIncompatibility)(nil), "metastore.SchemaIncompatibility")
+	proto.RegisterType((*AddColumnsRequest)(nil), "metastore.AddColumnsRequest")
+	proto.RegisterType((*ReplaceColumnsRequest)(nil), "metastore.ReplaceColumnsRequest")
+	proto.RegisterType((*ChangeColumnRequest)(nil), "metastore.ChangeColumnRequest")
+	proto.RegisterType((*DropColumnRequest)(nil), "metastore.DropColumnRequest")
+	proto.RegisterType((*AlterTableResponse)(nil), "metastore.AlterTableResponse")
 	proto.RegisterType((*Partition)(nil), "metastore.Partition")
 	proto.RegisterType((*AddPartitionRequest)(nil), "metastore.AddPartitionRequest")
 	proto.RegisterType((*AddPartitionResponse)(nil), "metastore.AddPartitionResponse")
@@ -1466,12 +2908,21 @@ func init() {
 	proto.RegisterType((*ListPartitionsRequest)(nil), "metastore.ListPartitionsRequest")
 	proto.RegisterType((*PartitionValues)(nil), "metastore.PartitionValues")
 	proto.RegisterType((*DropPartitionsRequest)(nil), "metastore.DropPartitionsRequest")
+	proto.RegisterType((*GetPartitionNamesRequest)(nil), "metastore.GetPartitionNamesRequest")
+	proto.RegisterType((*GetPartitionNamesResponse)(nil), "metastore.GetPartitionNamesResponse")
+	proto.RegisterType((*ResolveIdRequest)(nil), "metastore.ResolveIdRequest")
+	proto.RegisterType((*ResolveIdResponse)(nil), "metastore.ResolveIdResponse")
+	proto.RegisterType((*BackupRequest)(nil), "metastore.BackupRequest")
+	proto.RegisterType((*BackupChunk)(nil), "metastore.BackupChunk")
 	proto.RegisterEnum("metastore.SerdeType", SerdeType_name, SerdeType_value)
 	proto.RegisterEnum("metastore.InputFormat", InputFormat_name, InputFormat_value)
 	proto.RegisterEnum("metastore.OutputFormat", OutputFormat_name, OutputFormat_value)
 	proto.RegisterEnum("metastore.TableType", TableType_name, TableType_value)
 	proto.RegisterEnum("metastore.SerializationLib", SerializationLib_name, SerializationLib_value)
 	proto.RegisterEnum("metastore.RequestStatus_Status", RequestStatus_Status_name, RequestStatus_Status_value)
+	proto.RegisterEnum("metastore.DroppedObject_Kind", DroppedObject_Kind_name, DroppedObject_Kind_value)
+	proto.RegisterEnum("metastore.SchemaIncompatibility_Kind", SchemaIncompatibility_Kind_name, SchemaIncompatibility_Kind_value)
+	proto.RegisterEnum("metastore.ResolveIdResponse_Kind", ResolveIdResponse_Kind_name, ResolveIdResponse_Kind_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1485,6 +2936,16 @@ const _ = grpc.SupportPackageIsVersion4
 // Client API for Metastore service
 
 type MetastoreClient interface {
+	// Create a new catalog
+	CreateCatalog(ctx context.Context, in *CreateCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error)
+	// Get catalog information
+	GetCatalog(ctx context.Context, in *GetCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error)
+	// Return all catalogs
+	ListCatalogs(ctx context.Context, in *ListCatalogsRequest, opts ...grpc.CallOption) (Metastore_ListCatalogsClient, error)
+	// Alter catalog
+	AlterCatalog(ctx context.Context, in *AlterCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error)
+	// Destroy an empty catalog
+	DropCatalog(ctx context.Context, in *DropCatalogRequest, opts ...grpc.CallOption) (*RequestStatus, error)
 	// Create a new database.
 	CreateDabatase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*GetDatabaseResponse, error)
 	// Get database information
@@ -1503,6 +2964,20 @@ type MetastoreClient interface {
 	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (Metastore_ListTablesClient, error)
 	// Destroy a table
 	DropTable(ctx context.Context, in *DropTableRequest, opts ...grpc.CallOption) (*RequestStatus, error)
+	// Alter table
+	AlterTable(ctx context.Context, in *AlterTableRequest, opts ...grpc.CallOption) (*AlterTableResponse, error)
+	// Add columns after existing table columns
+	AddColumns(ctx context.Context, in *AddColumnsRequest, opts ...grpc.CallOption) (*AlterTableResponse, error)
+	// Replace all table columns
+	ReplaceColumns(ctx context.Context, in *ReplaceColumnsRequest, opts ...grpc.CallOption) (*AlterTableResponse, error)
+	// Rename, retype, move or comment a single table column
+	ChangeColumn(ctx context.Context, in *ChangeColumnRequest, opts ...grpc.CallOption) (*AlterTableResponse, error)
+	// Drop a single table column
+	DropColumn(ctx context.Context, in *DropColumnRequest, opts ...grpc.CallOption) (*AlterTableResponse, error)
+	// List dropped tables and databases kept in trash
+	ListDropped(ctx context.Context, in *ListDroppedRequest, opts ...grpc.CallOption) (Metastore_ListDroppedClient, error)
+	// Restore dropped table or database from trash
+	Undrop(ctx context.Context, in *UndropRequest, opts ...grpc.CallOption) (*RequestStatus, error)
 	// Add partition to a table
 	AddPartition(ctx context.Context, in *AddPartitionRequest, opts ...grpc.CallOption) (*AddPartitionResponse, error)
 	// Add multiple partitions. The first request contains DB and table info,
@@ -1513,8 +2988,14 @@ type MetastoreClient interface {
 	GetPartition(ctx context.Context, in *GetPartitionRequest, opts ...grpc.CallOption) (*GetPartitionResponse, error)
 	// List all partitions in a table
 	ListPartitions(ctx context.Context, in *ListPartitionsRequest, opts ...grpc.CallOption) (Metastore_ListPartitionsClient, error)
+	// Get names of all partitions in a table
+	GetPartitionNames(ctx context.Context, in *GetPartitionNamesRequest, opts ...grpc.CallOption) (*GetPartitionNamesResponse, error)
 	// Drop partition
 	DropPartitions(ctx context.Context, in *DropPartitionsRequest, opts ...grpc.CallOption) (*RequestStatus, error)
+	// Find database, table or partition by its ID
+	ResolveId(ctx context.Context, in *ResolveIdRequest, opts ...grpc.CallOption) (*ResolveIdResponse, error)
+	// Stream consistent snapshot of the whole metastore database
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (Metastore_BackupClient, error)
 }
 
 type metastoreClient struct {
@@ -1525,6 +3006,74 @@ func NewMetastoreClient(cc *grpc.ClientConn) MetastoreClient {
 	return &metastoreClient{cc}
 }
 
+func (c *metastoreClient) CreateCatalog(ctx context.Context, in *CreateCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error) {
+	out := new(GetCatalogResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/CreateCatalog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) GetCatalog(ctx context.Context, in *GetCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error) {
+	out := new(GetCatalogResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/GetCatalog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) ListCatalogs(ctx context.Context, in *ListCatalogsRequest, opts ...grpc.CallOption) (Metastore_ListCatalogsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[0], c.cc, "/metastore.Metastore/ListCatalogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metastoreListCatalogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Metastore_ListCatalogsClient interface {
+	Recv() (*Catalog, error)
+	grpc.ClientStream
+}
+
+type metastoreListCatalogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *metastoreListCatalogsClient) Recv() (*Catalog, error) {
+	m := new(Catalog)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metastoreClient) AlterCatalog(ctx context.Context, in *AlterCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error) {
+	out := new(GetCatalogResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/AlterCatalog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) DropCatalog(ctx context.Context, in *DropCatalogRequest, opts ...grpc.CallOption) (*RequestStatus, error) {
+	out := new(RequestStatus)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/DropCatalog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metastoreClient) CreateDabatase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*GetDatabaseResponse, error) {
 	out := new(GetDatabaseResponse)
 	err := grpc.Invoke(ctx, "/metastore.Metastore/CreateDabatase", in, out, c.cc, opts...)
@@ -1544,7 +3093,7 @@ func (c *metastoreClient) GetDatabase(ctx context.Context, in *GetDatabaseReques
 }
 
 func (c *metastoreClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (Metastore_ListDatabasesClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[0], c.cc, "/metastore.Metastore/ListDatabases", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[1], c.cc, "/metastore.Metastore/ListDatabases", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1612,7 +3161,7 @@ func (c *metastoreClient) GetTable(ctx context.Context, in *GetTableRequest, opt
 }
 
 func (c *metastoreClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (Metastore_ListTablesClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[1], c.cc, "/metastore.Metastore/ListTables", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[2], c.cc, "/metastore.Metastore/ListTables", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1652,6 +3201,92 @@ func (c *metastoreClient) DropTable(ctx context.Context, in *DropTableRequest, o
 	return out, nil
 }
 
+func (c *metastoreClient) AlterTable(ctx context.Context, in *AlterTableRequest, opts ...grpc.CallOption) (*AlterTableResponse, error) {
+	out := new(AlterTableResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/AlterTable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) AddColumns(ctx context.Context, in *AddColumnsRequest, opts ...grpc.CallOption) (*AlterTableResponse, error) {
+	out := new(AlterTableResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/AddColumns", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) ReplaceColumns(ctx context.Context, in *ReplaceColumnsRequest, opts ...grpc.CallOption) (*AlterTableResponse, error) {
+	out := new(AlterTableResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/ReplaceColumns", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) ChangeColumn(ctx context.Context, in *ChangeColumnRequest, opts ...grpc.CallOption) (*AlterTableResponse, error) {
+	out := new(AlterTableResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/ChangeColumn", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) DropColumn(ctx context.Context, in *DropColumnRequest, opts ...grpc.CallOption) (*AlterTableResponse, error) {
+	out := new(AlterTableResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/DropColumn", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) ListDropped(ctx context.Context, in *ListDroppedRequest, opts ...grpc.CallOption) (Metastore_ListDroppedClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[3], c.cc, "/metastore.Metastore/ListDropped", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metastoreListDroppedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Metastore_ListDroppedClient interface {
+	Recv() (*DroppedObject, error)
+	grpc.ClientStream
+}
+
+type metastoreListDroppedClient struct {
+	grpc.ClientStream
+}
+
+func (x *metastoreListDroppedClient) Recv() (*DroppedObject, error) {
+	m := new(DroppedObject)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metastoreClient) Undrop(ctx context.Context, in *UndropRequest, opts ...grpc.CallOption) (*RequestStatus, error) {
+	out := new(RequestStatus)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/Undrop", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metastoreClient) AddPartition(ctx context.Context, in *AddPartitionRequest, opts ...grpc.CallOption) (*AddPartitionResponse, error) {
 	out := new(AddPartitionResponse)
 	err := grpc.Invoke(ctx, "/metastore.Metastore/AddPartition", in, out, c.cc, opts...)
@@ -1662,7 +3297,7 @@ func (c *metastoreClient) AddPartition(ctx context.Context, in *AddPartitionRequ
 }
 
 func (c *metastoreClient) AddManyPartitions(ctx context.Context, opts ...grpc.CallOption) (Metastore_AddManyPartitionsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[2], c.cc, "/metastore.Metastore/AddManyPartitions", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[4], c.cc, "/metastore.Metastore/AddManyPartitions", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1689,24 +3324,83 @@ func (x *metastoreAddManyPartitionsClient) Recv() (*AddPartitionResponse, error)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return m, nil
+	return m, nil
+}
+
+func (c *metastoreClient) GetPartition(ctx context.Context, in *GetPartitionRequest, opts ...grpc.CallOption) (*GetPartitionResponse, error) {
+	out := new(GetPartitionResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/GetPartition", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) ListPartitions(ctx context.Context, in *ListPartitionsRequest, opts ...grpc.CallOption) (Metastore_ListPartitionsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[5], c.cc, "/metastore.Metastore/ListPartitions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metastoreListPartitionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Metastore_ListPartitionsClient interface {
+	Recv() (*Partition, error)
+	grpc.ClientStream
+}
+
+type metastoreListPartitionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *metastoreListPartitionsClient) Recv() (*Partition, error) {
+	m := new(Partition)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metastoreClient) GetPartitionNames(ctx context.Context, in *GetPartitionNamesRequest, opts ...grpc.CallOption) (*GetPartitionNamesResponse, error) {
+	out := new(GetPartitionNamesResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/GetPartitionNames", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreClient) DropPartitions(ctx context.Context, in *DropPartitionsRequest, opts ...grpc.CallOption) (*RequestStatus, error) {
+	out := new(RequestStatus)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/DropPartitions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *metastoreClient) GetPartition(ctx context.Context, in *GetPartitionRequest, opts ...grpc.CallOption) (*GetPartitionResponse, error) {
-	out := new(GetPartitionResponse)
-	err := grpc.Invoke(ctx, "/metastore.Metastore/GetPartition", in, out, c.cc, opts...)
+func (c *metastoreClient) ResolveId(ctx context.Context, in *ResolveIdRequest, opts ...grpc.CallOption) (*ResolveIdResponse, error) {
+	out := new(ResolveIdResponse)
+	err := grpc.Invoke(ctx, "/metastore.Metastore/ResolveId", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *metastoreClient) ListPartitions(ctx context.Context, in *ListPartitionsRequest, opts ...grpc.CallOption) (Metastore_ListPartitionsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[3], c.cc, "/metastore.Metastore/ListPartitions", opts...)
+func (c *metastoreClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (Metastore_BackupClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Metastore_serviceDesc.Streams[6], c.cc, "/metastore.Metastore/Backup", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &metastoreListPartitionsClient{stream}
+	x := &metastoreBackupClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -1716,35 +3410,36 @@ func (c *metastoreClient) ListPartitions(ctx context.Context, in *ListPartitions
 	return x, nil
 }
 
-type Metastore_ListPartitionsClient interface {
-	Recv() (*Partition, error)
+type Metastore_BackupClient interface {
+	Recv() (*BackupChunk, error)
 	grpc.ClientStream
 }
 
-type metastoreListPartitionsClient struct {
+type metastoreBackupClient struct {
 	grpc.ClientStream
 }
 
-func (x *metastoreListPartitionsClient) Recv() (*Partition, error) {
-	m := new(Partition)
+func (x *metastoreBackupClient) Recv() (*BackupChunk, error) {
+	m := new(BackupChunk)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *metastoreClient) DropPartitions(ctx context.Context, in *DropPartitionsRequest, opts ...grpc.CallOption) (*RequestStatus, error) {
-	out := new(RequestStatus)
-	err := grpc.Invoke(ctx, "/metastore.Metastore/DropPartitions", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
 // Server API for Metastore service
 
 type MetastoreServer interface {
+	// Create a new catalog
+	CreateCatalog(context.Context, *CreateCatalogRequest) (*GetCatalogResponse, error)
+	// Get catalog information
+	GetCatalog(context.Context, *GetCatalogRequest) (*GetCatalogResponse, error)
+	// Return all catalogs
+	ListCatalogs(*ListCatalogsRequest, Metastore_ListCatalogsServer) error
+	// Alter catalog
+	AlterCatalog(context.Context, *AlterCatalogRequest) (*GetCatalogResponse, error)
+	// Destroy an empty catalog
+	DropCatalog(context.Context, *DropCatalogRequest) (*RequestStatus, error)
 	// Create a new database.
 	CreateDabatase(context.Context, *CreateDatabaseRequest) (*GetDatabaseResponse, error)
 	// Get database information
@@ -1763,6 +3458,20 @@ type MetastoreServer interface {
 	ListTables(*ListTablesRequest, Metastore_ListTablesServer) error
 	// Destroy a table
 	DropTable(context.Context, *DropTableRequest) (*RequestStatus, error)
+	// Alter table
+	AlterTable(context.Context, *AlterTableRequest) (*AlterTableResponse, error)
+	// Add columns after existing table columns
+	AddColumns(context.Context, *AddColumnsRequest) (*AlterTableResponse, error)
+	// Replace all table columns
+	ReplaceColumns(context.Context, *ReplaceColumnsRequest) (*AlterTableResponse, error)
+	// Rename, retype, move or comment a single table column
+	ChangeColumn(context.Context, *ChangeColumnRequest) (*AlterTableResponse, error)
+	// Drop a single table column
+	DropColumn(context.Context, *DropColumnRequest) (*AlterTableResponse, error)
+	// List dropped tables and databases kept in trash
+	ListDropped(*ListDroppedRequest, Metastore_ListDroppedServer) error
+	// Restore dropped table or database from trash
+	Undrop(context.Context, *UndropRequest) (*RequestStatus, error)
 	// Add partition to a table
 	AddPartition(context.Context, *AddPartitionRequest) (*AddPartitionResponse, error)
 	// Add multiple partitions. The first request contains DB and table info,
@@ -1773,14 +3482,113 @@ type MetastoreServer interface {
 	GetPartition(context.Context, *GetPartitionRequest) (*GetPartitionResponse, error)
 	// List all partitions in a table
 	ListPartitions(*ListPartitionsRequest, Metastore_ListPartitionsServer) error
+	// Get names of all partitions in a table
+	GetPartitionNames(context.Context, *GetPartitionNamesRequest) (*GetPartitionNamesResponse, error)
 	// Drop partition
 	DropPartitions(context.Context, *DropPartitionsRequest) (*RequestStatus, error)
+	// Find database, table or partition by its ID
+	ResolveId(context.Context, *ResolveIdRequest) (*ResolveIdResponse, error)
+	// Stream consistent snapshot of the whole metastore database
+	Backup(*BackupRequest, Metastore_BackupServer) error
 }
 
 func RegisterMetastoreServer(s *grpc.Server, srv MetastoreServer) {
 	s.RegisterService(&_Metastore_serviceDesc, srv)
 }
 
+func _Metastore_CreateCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).CreateCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/CreateCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).CreateCatalog(ctx, req.(*CreateCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_GetCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).GetCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/GetCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).GetCatalog(ctx, req.(*GetCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_ListCatalogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCatalogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetastoreServer).ListCatalogs(m, &metastoreListCatalogsServer{stream})
+}
+
+type Metastore_ListCatalogsServer interface {
+	Send(*Catalog) error
+	grpc.ServerStream
+}
+
+type metastoreListCatalogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *metastoreListCatalogsServer) Send(m *Catalog) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Metastore_AlterCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).AlterCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/AlterCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).AlterCatalog(ctx, req.(*AlterCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_DropCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).DropCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/DropCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).DropCatalog(ctx, req.(*DropCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metastore_CreateDabatase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateDatabaseRequest)
 	if err := dec(in); err != nil {
@@ -1949,6 +3757,135 @@ func _Metastore_DropTable_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metastore_AlterTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).AlterTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/AlterTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).AlterTable(ctx, req.(*AlterTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_AddColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).AddColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/AddColumns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).AddColumns(ctx, req.(*AddColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_ReplaceColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).ReplaceColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/ReplaceColumns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).ReplaceColumns(ctx, req.(*ReplaceColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_ChangeColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).ChangeColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/ChangeColumn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).ChangeColumn(ctx, req.(*ChangeColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_DropColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).DropColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/DropColumn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).DropColumn(ctx, req.(*DropColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_ListDropped_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDroppedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetastoreServer).ListDropped(m, &metastoreListDroppedServer{stream})
+}
+
+type Metastore_ListDroppedServer interface {
+	Send(*DroppedObject) error
+	grpc.ServerStream
+}
+
+type metastoreListDroppedServer struct {
+	grpc.ServerStream
+}
+
+func (x *metastoreListDroppedServer) Send(m *DroppedObject) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Metastore_Undrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).Undrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/Undrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).Undrop(ctx, req.(*UndropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metastore_AddPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPartitionRequest)
 	if err := dec(in); err != nil {
@@ -2032,6 +3969,24 @@ func (x *metastoreListPartitionsServer) Send(m *Partition) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Metastore_GetPartitionNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).GetPartitionNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/GetPartitionNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).GetPartitionNames(ctx, req.(*GetPartitionNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metastore_DropPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DropPartitionsRequest)
 	if err := dec(in); err != nil {
@@ -2050,10 +4005,65 @@ func _Metastore_DropPartitions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metastore_ResolveId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServer).ResolveId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metastore.Metastore/ResolveId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServer).ResolveId(ctx, req.(*ResolveIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metastore_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetastoreServer).Backup(m, &metastoreBackupServer{stream})
+}
+
+type Metastore_BackupServer interface {
+	Send(*BackupChunk) error
+	grpc.ServerStream
+}
+
+type metastoreBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *metastoreBackupServer) Send(m *BackupChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Metastore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metastore.Metastore",
 	HandlerType: (*MetastoreServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCatalog",
+			Handler:    _Metastore_CreateCatalog_Handler,
+		},
+		{
+			MethodName: "GetCatalog",
+			Handler:    _Metastore_GetCatalog_Handler,
+		},
+		{
+			MethodName: "AlterCatalog",
+			Handler:    _Metastore_AlterCatalog_Handler,
+		},
+		{
+			MethodName: "DropCatalog",
+			Handler:    _Metastore_DropCatalog_Handler,
+		},
 		{
 			MethodName: "CreateDabatase",
 			Handler:    _Metastore_CreateDabatase_Handler,
@@ -2082,6 +4092,30 @@ var _Metastore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropTable",
 			Handler:    _Metastore_DropTable_Handler,
 		},
+		{
+			MethodName: "AlterTable",
+			Handler:    _Metastore_AlterTable_Handler,
+		},
+		{
+			MethodName: "AddColumns",
+			Handler:    _Metastore_AddColumns_Handler,
+		},
+		{
+			MethodName: "ReplaceColumns",
+			Handler:    _Metastore_ReplaceColumns_Handler,
+		},
+		{
+			MethodName: "ChangeColumn",
+			Handler:    _Metastore_ChangeColumn_Handler,
+		},
+		{
+			MethodName: "DropColumn",
+			Handler:    _Metastore_DropColumn_Handler,
+		},
+		{
+			MethodName: "Undrop",
+			Handler:    _Metastore_Undrop_Handler,
+		},
 		{
 			MethodName: "AddPartition",
 			Handler:    _Metastore_AddPartition_Handler,
@@ -2090,12 +4124,25 @@ var _Metastore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPartition",
 			Handler:    _Metastore_GetPartition_Handler,
 		},
+		{
+			MethodName: "GetPartitionNames",
+			Handler:    _Metastore_GetPartitionNames_Handler,
+		},
 		{
 			MethodName: "DropPartitions",
 			Handler:    _Metastore_DropPartitions_Handler,
 		},
+		{
+			MethodName: "ResolveId",
+			Handler:    _Metastore_ResolveId_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListCatalogs",
+			Handler:       _Metastore_ListCatalogs_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListDatabases",
 			Handler:       _Metastore_ListDatabases_Handler,
@@ -2106,6 +4153,11 @@ var _Metastore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Metastore_ListTables_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListDropped",
+			Handler:       _Metastore_ListDropped_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "AddManyPartitions",
 			Handler:       _Metastore_AddManyPartitions_Handler,
@@ -2117,6 +4169,11 @@ var _Metastore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Metastore_ListPartitions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Backup",
+			Handler:       _Metastore_Backup_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "metastore.proto",
 }
@@ -2124,137 +4181,228 @@ var _Metastore_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("metastore.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2107 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0xe3, 0xc6,
-	0x15, 0x5f, 0x52, 0x1f, 0x96, 0x9e, 0x6c, 0x99, 0x1e, 0xdb, 0x1b, 0x41, 0xd9, 0xdd, 0xa8, 0x6c,
-	0x9b, 0xaa, 0x86, 0x63, 0x39, 0xea, 0xc7, 0x06, 0x69, 0x02, 0x44, 0x96, 0x28, 0x2f, 0xbb, 0xb2,
-	0xa8, 0x50, 0xb2, 0xeb, 0xed, 0xa1, 0x02, 0x25, 0xce, 0xda, 0xcc, 0x4a, 0xa2, 0x96, 0xa4, 0x36,
-	0x71, 0xd2, 0x3d, 0xb4, 0x87, 0x16, 0x3d, 0xf4, 0x92, 0xb6, 0x40, 0xff, 0x84, 0xa2, 0xc7, 0xfe,
-	0x1b, 0x45, 0x81, 0x02, 0x3d, 0xb4, 0x97, 0x1e, 0x0a, 0xf4, 0xaf, 0x28, 0x10, 0xa0, 0x98, 0xe1,
-	0xd7, 0x50, 0xa6, 0x64, 0x3b, 0xbb, 0xc5, 0x36, 0x27, 0x71, 0xde, 0xbc, 0x79, 0xdf, 0xf3, 0xe6,
-	0x37, 0x23, 0x58, 0x1f, 0x63, 0x47, 0xb3, 0x1d, 0xd3, 0xc2, 0x7b, 0x53, 0xcb, 0x74, 0x4c, 0x94,
-	0x0d, 0x08, 0xc5, 0x3b, 0x67, 0xa6, 0x79, 0x36, 0xc2, 0x15, 0x6d, 0x6a, 0x54, 0xb4, 0xc9, 0xc4,
-	0x74, 0x34, 0xc7, 0x30, 0x27, 0xb6, 0xcb, 0x58, 0xdc, 0xa5, 0x3f, 0xc3, 0xb7, 0xce, 0xf0, 0xe4,
-	0x2d, 0xfb, 0x63, 0xed, 0xec, 0x0c, 0x5b, 0x15, 0x73, 0x4a, 0x39, 0x2e, 0x73, 0x8b, 0xff, 0xe4,
-	0x60, 0x4d, 0xc5, 0x4f, 0x67, 0xd8, 0x76, 0xba, 0x8e, 0xe6, 0xcc, 0x6c, 0x74, 0x1f, 0xd2, 0x36,
-	0xfd, 0x2a, 0x70, 0x25, 0xae, 0x9c, 0xaf, 0xbe, 0xb1, 0x17, 0x9a, 0x12, 0xe1, 0xdc, 0x73, 0x7f,
-	0x54, 0x8f, 0x1d, 0x6d, 0x41, 0x0a, 0x5b, 0x96, 0x69, 0x15, 0xf8, 0x12, 0x57, 0xce, 0xaa, 0xee,
-	0x40, 0x7c, 0x0e, 0x69, 0x4f, 0xf0, 0x1a, 0x64, 0xbb, 0xbd, 0x5a, 0xef, 0xb8, 0xdb, 0x57, 0x1e,
-	0x0a, 0xb7, 0x90, 0x00, 0xab, 0xde, 0x50, 0x52, 0x55, 0x45, 0x15, 0x38, 0xb4, 0x09, 0xeb, 0x1e,
-	0xa5, 0xad, 0xf4, 0x9a, 0xca, 0x71, 0xbb, 0x21, 0xf0, 0x0c, 0xb1, 0xae, 0xb4, 0x9b, 0x2d, 0xb9,
-	0xde, 0x13, 0x12, 0x68, 0x1d, 0x72, 0x1e, 0xf1, 0xe0, 0xb8, 0xfb, 0x48, 0x48, 0xa2, 0xd7, 0x60,
-	0xd3, 0x23, 0xc8, 0xed, 0x9e, 0xa4, 0xb6, 0x6b, 0x2d, 0x22, 0x55, 0x48, 0x89, 0x65, 0xe0, 0x65,
-	0x1d, 0x21, 0x48, 0x4e, 0xb4, 0x31, 0xa6, 0x1e, 0x65, 0x55, 0xfa, 0x8d, 0xf2, 0xc0, 0x1b, 0xba,
-	0x67, 0x2b, 0x6f, 0xe8, 0xe2, 0x17, 0x3c, 0x64, 0x1a, 0x9a, 0xa3, 0x0d, 0x34, 0x1b, 0xa3, 0xbb,
-	0x74, 0x92, 0xb0, 0xe7, 0xaa, 0x6b, 0x4c, 0x00, 0x64, 0x9d, 0xf0, 0xa2, 0x6d, 0x48, 0xdb, 0xf8,
-	0x69, 0xdf, 0x5b, 0x9f, 0x54, 0x53, 0x36, 0x7e, 0x2a, 0xeb, 0xa8, 0x08, 0x99, 0x91, 0x39, 0xa4,
-	0xf1, 0x2d, 0x24, 0xa8, 0xe0, 0x60, 0x8c, 0xea, 0x00, 0x53, 0xcd, 0xd2, 0xc6, 0xd8, 0xc1, 0x96,
-	0x5d, 0x48, 0x96, 0x12, 0xe5, 0x5c, 0xf5, 0xeb, 0x8c, 0x64, 0x5f, 0xf5, 0x5e, 0x27, 0xe0, 0x92,
-	0x26, 0x8e, 0x75, 0xa1, 0x32, 0xcb, 0xd0, 0x09, 0x6c, 0xd8, 0x17, 0xb6, 0x83, 0xc7, 0x7d, 0x46,
-	0x56, 0x8a, 0xca, 0xfa, 0x76, 0x9c, 0xac, 0x2e, 0x65, 0x9e, 0x97, 0x28, 0xd8, 0x73, 0xe4, 0xe2,
-	0xfb, 0xb0, 0x3e, 0xc7, 0x84, 0x04, 0x48, 0x3c, 0xc1, 0x17, 0x5e, 0xc4, 0xc8, 0x27, 0xc9, 0xef,
-	0x33, 0x6d, 0x34, 0xc3, 0x7e, 0x7e, 0xe9, 0xe0, 0x5d, 0xfe, 0x1d, 0xae, 0x58, 0x87, 0xed, 0x58,
-	0x4d, 0x37, 0x11, 0x22, 0x7e, 0x0a, 0xdb, 0x75, 0x0b, 0x6b, 0x0e, 0xf6, 0xad, 0xf7, 0x8a, 0x0d,
-	0x15, 0x60, 0x65, 0xa8, 0x39, 0xda, 0xc8, 0x3c, 0xf3, 0x04, 0xf9, 0x43, 0x54, 0x81, 0x8c, 0xee,
-	0x31, 0x53, 0x79, 0xb9, 0xea, 0x66, 0x4c, 0x14, 0xd4, 0x80, 0x09, 0xdd, 0x86, 0xf4, 0xd0, 0x34,
-	0x9f, 0x18, 0xd8, 0x4b, 0x8f, 0x37, 0x12, 0x7f, 0xcf, 0xc1, 0x56, 0x6d, 0xe4, 0x60, 0xeb, 0xfa,
-	0xba, 0xef, 0x06, 0xe5, 0x13, 0x5b, 0x21, 0xac, 0x69, 0x89, 0x9b, 0x99, 0x96, 0x8c, 0x98, 0x86,
-	0x01, 0x1d, 0x62, 0xe7, 0xa5, 0xd9, 0xb5, 0x28, 0x02, 0x9f, 0xc0, 0x66, 0x44, 0x8d, 0x3d, 0x35,
-	0x27, 0x36, 0x8e, 0xb8, 0xc1, 0x5d, 0xc7, 0x8d, 0xfd, 0xa0, 0x7b, 0xb8, 0x26, 0x14, 0x16, 0x75,
-	0x0f, 0xbf, 0x6d, 0x88, 0x7f, 0xe4, 0x60, 0xab, 0x65, 0xd8, 0x81, 0x6e, 0xfb, 0x6a, 0x1f, 0x43,
-	0x27, 0x78, 0xd6, 0x09, 0xf4, 0x35, 0x58, 0x25, 0x5b, 0xbb, 0x3f, 0xd5, 0x1c, 0x07, 0x5b, 0xfe,
-	0x1e, 0xcc, 0x11, 0x5a, 0xc7, 0x25, 0xa1, 0x6f, 0x42, 0x1e, 0x7f, 0x32, 0x1c, 0xcd, 0x74, 0xec,
-	0x6e, 0x21, 0x9b, 0x86, 0x3b, 0xa3, 0xae, 0x79, 0x54, 0x5a, 0xc1, 0x36, 0xd1, 0xf0, 0xd8, 0xc0,
-	0x23, 0xdd, 0xdd, 0x5d, 0x59, 0xd5, 0x1b, 0x89, 0x8f, 0x61, 0xb3, 0x61, 0x99, 0xd3, 0xff, 0x79,
-	0x3a, 0x14, 0xc8, 0x35, 0x89, 0xc6, 0xee, 0xf0, 0x1c, 0x8f, 0xb5, 0xd8, 0xfe, 0x85, 0x20, 0xe9,
-	0x5c, 0x4c, 0xfd, 0x10, 0xd0, 0x6f, 0x6a, 0x87, 0x39, 0x1e, 0xe3, 0x89, 0xe3, 0xc9, 0xf3, 0x87,
-	0xe2, 0x7f, 0x38, 0xc8, 0x76, 0xb1, 0xd5, 0xc0, 0xf2, 0xe4, 0xb1, 0x89, 0xca, 0xde, 0x5a, 0xb7,
-	0xc3, 0x6f, 0x31, 0x76, 0x75, 0xb1, 0xa5, 0xe3, 0xde, 0xc5, 0x14, 0x7b, 0x12, 0x7d, 0xcd, 0x3c,
-	0xa3, 0x79, 0x07, 0x04, 0x1b, 0x5b, 0x86, 0x36, 0x32, 0x3e, 0xa5, 0xbd, 0xad, 0x65, 0x0c, 0x3c,
-	0x75, 0x97, 0xe8, 0xa8, 0x11, 0xd3, 0xf6, 0xbe, 0x11, 0xd5, 0xe7, 0xda, 0xb4, 0xac, 0xef, 0xbd,
-	0x60, 0x7f, 0x12, 0xef, 0x43, 0x4a, 0xb1, 0x74, 0x6c, 0x91, 0x45, 0x43, 0x73, 0xe4, 0x2f, 0x1a,
-	0x9a, 0x23, 0x74, 0x07, 0xb2, 0x9a, 0x3d, 0xc4, 0x13, 0xdd, 0x98, 0x9c, 0xd1, 0x85, 0x19, 0x35,
-	0x24, 0x88, 0xff, 0x4a, 0xc1, 0x46, 0xd7, 0x31, 0x2d, 0xed, 0x0c, 0x37, 0xb0, 0x3d, 0xb4, 0x8c,
-	0xa9, 0x63, 0x5a, 0x68, 0x07, 0x92, 0x43, 0x73, 0x44, 0xce, 0x47, 0xe2, 0xcd, 0x6d, 0xc6, 0x1b,
-	0x26, 0x67, 0x2a, 0xe5, 0x41, 0xef, 0x40, 0xce, 0x98, 0x4c, 0x67, 0x4e, 0xd3, 0xb4, 0xc6, 0x9a,
-	0x9b, 0x95, 0x7c, 0x64, 0x89, 0x1c, 0xce, 0xaa, 0x2c, 0x2b, 0x2a, 0xc3, 0x3a, 0x33, 0x6c, 0x93,
-	0x24, 0xb8, 0x9d, 0x61, 0x9e, 0x8c, 0x7e, 0x00, 0xab, 0xe6, 0xcc, 0x09, 0x95, 0xa4, 0xa8, 0x92,
-	0xd7, 0x18, 0x25, 0x0a, 0x33, 0xad, 0x46, 0x98, 0x49, 0x32, 0xd9, 0x31, 0xd5, 0x93, 0x76, 0x93,
-	0x39, 0x4f, 0x47, 0xf7, 0x00, 0x26, 0xb3, 0xf1, 0xc1, 0x6c, 0xf8, 0x04, 0x3b, 0x76, 0x61, 0xa5,
-	0xc4, 0x95, 0x53, 0x2a, 0x43, 0x41, 0x55, 0xc8, 0xda, 0xa4, 0x7e, 0x48, 0x3e, 0x0b, 0x19, 0x5a,
-	0xf3, 0x5b, 0x71, 0xb9, 0x56, 0x43, 0x36, 0x22, 0x73, 0x40, 0x97, 0xd7, 0x49, 0x48, 0xb3, 0x74,
-	0xb7, 0x31, 0x14, 0xb4, 0x0b, 0x19, 0xdb, 0xb4, 0xdc, 0x59, 0xa0, 0x01, 0x17, 0x58, 0xc7, 0x48,
-	0x5a, 0xd5, 0x80, 0x03, 0xb5, 0x22, 0xe5, 0x96, 0xa3, 0xfc, 0xbb, 0xac, 0x09, 0xf3, 0xc9, 0x5c,
-	0x7a, 0xdc, 0xf6, 0xe3, 0x8e, 0xdb, 0x55, 0x2a, 0xb4, 0xba, 0x54, 0xe8, 0x57, 0xe9, 0xdc, 0xfd,
-	0x6d, 0x12, 0x52, 0x3d, 0x6d, 0x30, 0xba, 0x01, 0xe8, 0x49, 0xb0, 0xa0, 0x67, 0x17, 0x78, 0x5b,
-	0xa7, 0xa5, 0x99, 0xab, 0xde, 0x59, 0x16, 0x15, 0x95, 0xb7, 0x75, 0xf4, 0x1e, 0xac, 0x4d, 0x35,
-	0xcb, 0x31, 0x48, 0x7f, 0x78, 0x88, 0x2f, 0x7c, 0xf4, 0xb2, 0x68, 0x13, 0x45, 0x99, 0x49, 0x81,
-	0x39, 0xc4, 0x54, 0xd2, 0xa0, 0x68, 0x95, 0x46, 0x9b, 0x57, 0xcf, 0x9f, 0x53, 0x43, 0x36, 0xf4,
-	0x41, 0xa4, 0x24, 0x56, 0xa8, 0xba, 0xd2, 0xfc, 0xa2, 0xa5, 0x65, 0xd0, 0x8d, 0x2b, 0x83, 0x0c,
-	0x15, 0xf4, 0xe6, 0x25, 0x41, 0xd7, 0x4c, 0x7d, 0x04, 0x2b, 0x66, 0xa3, 0x58, 0xf1, 0xff, 0xa2,
-	0x2c, 0x3e, 0xe7, 0x00, 0xb9, 0x78, 0x8c, 0xfa, 0x75, 0xf5, 0x49, 0x27, 0x42, 0x4a, 0x1f, 0xf4,
-	0x17, 0x1d, 0x76, 0x49, 0x7d, 0x20, 0xeb, 0xe8, 0x4d, 0x48, 0xd1, 0xc4, 0x78, 0x90, 0x48, 0x98,
-	0x8f, 0x9e, 0xea, 0x4e, 0x2f, 0x04, 0x43, 0xbf, 0xe0, 0x60, 0xfd, 0x10, 0x3b, 0x2f, 0xd1, 0x22,
-	0xb7, 0xe6, 0x13, 0x57, 0x9f, 0xcf, 0x51, 0x43, 0x46, 0x20, 0x84, 0x76, 0x78, 0x58, 0x29, 0x70,
-	0x8e, 0x5b, 0xee, 0xdc, 0xcd, 0x21, 0xd2, 0xcf, 0x38, 0xd8, 0x20, 0x10, 0x89, 0x8a, 0xb1, 0x5f,
-	0x8e, 0xe3, 0x0b, 0x90, 0x07, 0x83, 0x7c, 0x92, 0x11, 0xe4, 0xf3, 0x4b, 0x0e, 0x04, 0x02, 0x7d,
-	0x5e, 0x7d, 0xec, 0xff, 0xca, 0x43, 0xb6, 0xe3, 0xb7, 0x85, 0x2f, 0x79, 0x53, 0xbb, 0x0d, 0x69,
-	0x5a, 0xea, 0x76, 0x21, 0xe1, 0x7a, 0xe9, 0x8e, 0x6e, 0xd8, 0xcc, 0xa2, 0xe0, 0x26, 0x75, 0x09,
-	0xdc, 0x04, 0x56, 0x2e, 0x6d, 0x2f, 0x6c, 0x27, 0x48, 0xcf, 0xdd, 0x1a, 0x83, 0x9a, 0x5a, 0x59,
-	0x5a, 0x53, 0x2f, 0x0a, 0x90, 0xfe, 0xc2, 0xc1, 0x66, 0x4d, 0xd7, 0x03, 0x7b, 0xfd, 0xfc, 0x16,
-	0x21, 0x63, 0x93, 0xcf, 0xc9, 0xd0, 0xad, 0xea, 0xa4, 0x1a, 0x8c, 0xd9, 0xdc, 0xf3, 0x0b, 0x72,
-	0x9f, 0x58, 0x9c, 0xfb, 0x32, 0x64, 0xa8, 0xe5, 0x84, 0x2d, 0x19, 0xc7, 0xb6, 0x42, 0xa7, 0x65,
-	0x9d, 0xf4, 0xfc, 0xe0, 0x10, 0xa0, 0xd0, 0x26, 0x0a, 0x2a, 0x42, 0x9b, 0x43, 0x36, 0x51, 0x87,
-	0xad, 0xa8, 0x3b, 0xde, 0x16, 0x5d, 0xe6, 0xcf, 0xcd, 0xb7, 0xe5, 0xef, 0x38, 0x7a, 0x69, 0xba,
-	0x14, 0xb5, 0x17, 0xdb, 0x15, 0x6c, 0x64, 0x12, 0x4b, 0x23, 0x13, 0x16, 0x71, 0x92, 0x2d, 0x62,
-	0xf1, 0xa7, 0xb0, 0x15, 0x35, 0xcb, 0xf3, 0x3e, 0x12, 0x49, 0xee, 0x5a, 0x91, 0xfc, 0x12, 0x51,
-	0xf9, 0x82, 0x83, 0x6d, 0xd2, 0xac, 0x02, 0x71, 0xf6, 0x2b, 0x88, 0x4b, 0x5c, 0xe3, 0x58, 0x74,
-	0xa9, 0x43, 0xd5, 0x20, 0x8e, 0x69, 0xba, 0x85, 0x8b, 0x71, 0x41, 0x39, 0xa1, 0x1c, 0x41, 0xa3,
-	0x28, 0xc0, 0x8a, 0x77, 0x63, 0xa4, 0x90, 0x22, 0xab, 0xfa, 0x43, 0xf1, 0x5b, 0x74, 0x2b, 0xb2,
-	0x8b, 0xc2, 0x8d, 0xc7, 0x51, 0x56, 0x77, 0x20, 0xfe, 0x99, 0x83, 0x6d, 0xd2, 0x51, 0x5f, 0x5d,
-	0xa0, 0xaa, 0x91, 0x02, 0xba, 0x9e, 0xe3, 0x61, 0x70, 0x53, 0x6c, 0x70, 0x77, 0xfe, 0xe0, 0x5e,
-	0x30, 0xdd, 0xcb, 0x23, 0x7d, 0xdc, 0x93, 0xd4, 0x86, 0xd4, 0xaf, 0x1f, 0x77, 0x7b, 0xca, 0x91,
-	0x70, 0x0b, 0x6d, 0xc3, 0x86, 0x4b, 0x69, 0xd5, 0x7e, 0xfc, 0xa8, 0xdf, 0x95, 0x8f, 0x3a, 0x2d,
-	0x49, 0xe0, 0x50, 0x1e, 0xc0, 0x25, 0xd7, 0x4e, 0x54, 0x45, 0xe0, 0xc3, 0xf1, 0x0f, 0xbb, 0x4a,
-	0x5b, 0x48, 0xd0, 0x47, 0x43, 0x3a, 0x56, 0xd4, 0xba, 0x90, 0xa4, 0x0f, 0x7f, 0x74, 0xa8, 0x4a,
-	0x87, 0xd2, 0xa9, 0x90, 0x0a, 0x15, 0xf5, 0x1e, 0xa8, 0x72, 0xb3, 0x27, 0xa4, 0xd1, 0x06, 0xac,
-	0xb9, 0x94, 0x4e, 0x4d, 0xfd, 0xf0, 0x58, 0xea, 0x09, 0x2b, 0xa1, 0x90, 0x7a, 0xf7, 0x44, 0xc8,
-	0xec, 0xfc, 0x08, 0x72, 0xcc, 0xad, 0x8b, 0xcc, 0xca, 0xcd, 0xd0, 0xd0, 0x75, 0xc8, 0xc9, 0xcd,
-	0x7e, 0x57, 0xfa, 0xf0, 0x58, 0x6a, 0xd7, 0x89, 0x89, 0x39, 0x58, 0x91, 0x9b, 0xfd, 0x9e, 0x74,
-	0xda, 0x13, 0x78, 0x6f, 0xf0, 0x40, 0x3e, 0x91, 0x84, 0x04, 0x31, 0x56, 0x6e, 0x06, 0x7a, 0x92,
-	0x3b, 0x3f, 0x81, 0x55, 0xf6, 0xa6, 0x45, 0x24, 0x2b, 0x51, 0xc9, 0x0a, 0x23, 0x99, 0x27, 0xa6,
-	0x2a, 0xcd, 0xbe, 0x7c, 0xd8, 0x56, 0x54, 0xa9, 0xff, 0x50, 0x7a, 0x24, 0x24, 0x88, 0x7c, 0xc5,
-	0x93, 0x9f, 0x24, 0xf2, 0x95, 0x50, 0x7e, 0x6a, 0xa7, 0x0e, 0xd9, 0x00, 0xe2, 0x92, 0xc5, 0xbd,
-	0xde, 0xa3, 0x8e, 0xd4, 0x3f, 0xaa, 0xb5, 0x6b, 0x87, 0x52, 0x43, 0xb8, 0x85, 0x10, 0xe4, 0x5d,
-	0x92, 0x74, 0xea, 0x3e, 0x82, 0x0a, 0x1c, 0x51, 0xea, 0xd2, 0xe4, 0x76, 0x43, 0x3a, 0x15, 0xf8,
-	0x1d, 0x09, 0x84, 0xee, 0xfc, 0x2d, 0x9d, 0x04, 0xa8, 0x15, 0x1a, 0x8a, 0x20, 0xdf, 0x6d, 0xc5,
-	0x24, 0xaa, 0x15, 0xd8, 0xc2, 0x57, 0xff, 0x0e, 0x90, 0x3d, 0xf2, 0xab, 0x05, 0xa9, 0x90, 0xf7,
-	0x1f, 0xef, 0x06, 0x9a, 0xa3, 0xd9, 0x18, 0xb1, 0x10, 0x3b, 0xf6, 0x5d, 0xaf, 0x78, 0x8f, 0xe1,
-	0x88, 0x7b, 0x7b, 0x9a, 0x42, 0x8e, 0x21, 0xa3, 0xbb, 0x8b, 0xd8, 0xaf, 0x25, 0x4d, 0x14, 0x7f,
-	0xfe, 0xb7, 0x7f, 0xff, 0x86, 0xbf, 0x83, 0x8a, 0x95, 0x67, 0xd5, 0x8a, 0x3e, 0xa8, 0x7c, 0xe6,
-	0x6d, 0xa9, 0xe7, 0x95, 0xcf, 0x0c, 0x7d, 0x6f, 0xa2, 0x8d, 0xf1, 0x73, 0xa4, 0xc1, 0x5a, 0xe4,
-	0x25, 0x0a, 0xb1, 0x6f, 0xdf, 0x71, 0x6f, 0x54, 0xc5, 0xb8, 0xd7, 0x30, 0xb1, 0x40, 0x55, 0x21,
-	0x24, 0xcc, 0xab, 0xda, 0xe7, 0xd0, 0x03, 0x58, 0x65, 0x1f, 0x90, 0x10, 0x6b, 0x76, 0xcc, 0xcb,
-	0x52, 0x71, 0x61, 0xbf, 0x45, 0x1d, 0x58, 0x8b, 0x3c, 0x59, 0x46, 0x8c, 0x8d, 0x7b, 0xcc, 0xbc,
-	0x32, 0xe0, 0x32, 0xe4, 0x18, 0xc4, 0x1f, 0x09, 0xf8, 0xe5, 0x9b, 0x40, 0xf1, 0xf5, 0xa8, 0xb4,
-	0x28, 0x16, 0xfe, 0x18, 0x32, 0x3e, 0x0d, 0x15, 0x63, 0x19, 0xaf, 0x16, 0x22, 0x56, 0x69, 0x1c,
-	0x77, 0xd1, 0x0e, 0x89, 0x23, 0xed, 0x59, 0x6c, 0xd6, 0x68, 0x1f, 0x74, 0x13, 0xc7, 0xa4, 0xf0,
-	0x1c, 0x20, 0x44, 0xca, 0xe8, 0xce, 0x5c, 0xfe, 0x22, 0x00, 0xba, 0x78, 0x09, 0x4d, 0x89, 0x65,
-	0xaa, 0x51, 0x44, 0xa5, 0xab, 0x34, 0xee, 0x73, 0xe8, 0x00, 0xb2, 0x01, 0x1e, 0x46, 0xaf, 0xcf,
-	0xa5, 0x31, 0xe2, 0xe4, 0xe2, 0x1c, 0x2a, 0xb0, 0xca, 0xe2, 0x94, 0x48, 0x35, 0xc4, 0xe0, 0xb1,
-	0xe2, 0x1b, 0x0b, 0xe7, 0xbd, 0xb8, 0x9f, 0xc2, 0x46, 0x4d, 0xd7, 0x8f, 0xb4, 0xc9, 0x45, 0x78,
-	0xaa, 0xbc, 0xb0, 0xd4, 0x32, 0xb7, 0xcf, 0xa1, 0x5f, 0x73, 0xb0, 0xca, 0xa2, 0x0a, 0x34, 0x57,
-	0x4d, 0x4b, 0xa5, 0xc6, 0xc1, 0x11, 0xf1, 0x3d, 0x1a, 0xec, 0xef, 0xa3, 0xef, 0x92, 0x60, 0x07,
-	0x88, 0x63, 0x61, 0x8a, 0xfd, 0x33, 0xcd, 0x4b, 0xf4, 0xaf, 0x38, 0xc8, 0x47, 0x61, 0x46, 0xa4,
-	0xe5, 0xc4, 0x22, 0x90, 0x62, 0x2c, 0xdc, 0x11, 0xdf, 0xa7, 0x86, 0xdc, 0x47, 0xdf, 0x8b, 0x18,
-	0x62, 0x5f, 0xd3, 0x92, 0x7d, 0x0e, 0xb5, 0x20, 0x1f, 0x3d, 0xc8, 0x23, 0xa6, 0xc4, 0x9e, 0xf1,
-	0x8b, 0x8b, 0xe2, 0xe0, 0x1f, 0xdc, 0xe7, 0xb5, 0x3f, 0x71, 0xe8, 0x23, 0x40, 0x0f, 0x8c, 0x67,
-	0xb8, 0x14, 0x34, 0xd9, 0x52, 0x6d, 0x6a, 0x88, 0x0a, 0xdc, 0x9e, 0xa3, 0x76, 0x2c, 0xf3, 0x23,
-	0x3c, 0x74, 0x90, 0x78, 0xee, 0x38, 0x53, 0xfb, 0xdd, 0x4a, 0xe5, 0xcc, 0x70, 0xce, 0x67, 0x83,
-	0xbd, 0xa1, 0x39, 0xae, 0x68, 0x4f, 0xcc, 0xd1, 0xe0, 0xed, 0xca, 0xf9, 0xd8, 0x7e, 0x56, 0xd5,
-	0xa6, 0x46, 0x71, 0xc3, 0x25, 0x7c, 0xe0, 0xfe, 0x81, 0x48, 0x58, 0xaa, 0x89, 0xb7, 0xf7, 0xf6,
-	0xad, 0x06, 0xdc, 0x63, 0xd4, 0x74, 0xe4, 0xd2, 0x49, 0xb5, 0xd4, 0x30, 0x87, 0xb3, 0x31, 0x9e,
-	0xb8, 0x7f, 0x17, 0x5e, 0x47, 0x3a, 0x6c, 0x0e, 0xcd, 0xf1, 0x1e, 0x25, 0x86, 0xbe, 0x1d, 0xd0,
-	0x43, 0x82, 0x5c, 0x9f, 0x70, 0x87, 0x1b, 0xa4, 0xe9, 0x9f, 0x8e, 0xdf, 0xf9, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xc0, 0x93, 0x1f, 0xb2, 0xde, 0x1c, 0x00, 0x00,
+	// 3568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x4d, 0x73, 0xe3, 0xc6,
+	0x95, 0x03, 0x7e, 0x89, 0x78, 0x14, 0x25, 0xa8, 0x25, 0x8d, 0x69, 0xce, 0x97, 0x0c, 0x7f, 0xac,
+	0xac, 0x1a, 0x4b, 0xb2, 0x76, 0xbd, 0xf6, 0x7a, 0xed, 0x5a, 0x53, 0x24, 0x35, 0x43, 0x8f, 0x44,
+	0xca, 0x20, 0x25, 0xcf, 0x6c, 0x6d, 0x2d, 0x0b, 0x22, 0x5a, 0x12, 0x2c, 0x92, 0xa0, 0x01, 0x68,
+	0x46, 0x1a, 0xc7, 0x97, 0x1c, 0x52, 0x95, 0x4a, 0xe5, 0x10, 0x3b, 0x87, 0x1c, 0x92, 0xbb, 0x0f,
+	0x39, 0xa4, 0x72, 0x48, 0x6e, 0xa9, 0xfc, 0x81, 0xa4, 0xe2, 0x24, 0x87, 0x9c, 0x52, 0x49, 0x55,
+	0x7e, 0x41, 0x2a, 0x97, 0xe4, 0x94, 0xea, 0x46, 0x03, 0xec, 0x06, 0x41, 0x8a, 0xf4, 0x7c, 0xb9,
+	0x72, 0x12, 0xfb, 0xf5, 0xc3, 0xeb, 0xf7, 0xd5, 0xaf, 0xdf, 0x7b, 0xdd, 0x82, 0xd9, 0x0e, 0x76,
+	0x75, 0xc7, 0xb5, 0x6c, 0xbc, 0xda, 0xb3, 0x2d, 0xd7, 0x42, 0x72, 0x00, 0xc8, 0x5f, 0x3d, 0xb2,
+	0xac, 0xa3, 0x36, 0x5e, 0xd3, 0x7b, 0xe6, 0x9a, 0xde, 0xed, 0x5a, 0xae, 0xee, 0x9a, 0x56, 0xd7,
+	0xf1, 0x10, 0xf3, 0x37, 0xe9, 0x9f, 0xd6, 0x6b, 0x47, 0xb8, 0xfb, 0x9a, 0xf3, 0x40, 0x3f, 0x3a,
+	0xc2, 0xf6, 0x9a, 0xd5, 0xa3, 0x18, 0x83, 0xd8, 0xea, 0x5f, 0x25, 0xc8, 0x6a, 0xf8, 0xe3, 0x53,
+	0xec, 0xb8, 0x75, 0x57, 0x77, 0x4f, 0x1d, 0xf4, 0x26, 0xa4, 0x1c, 0xfa, 0x2b, 0x27, 0x2d, 0x49,
+	0xcb, 0x33, 0x1b, 0x37, 0x56, 0xfb, 0xac, 0x08, 0x98, 0xab, 0xde, 0x1f, 0x8d, 0xa1, 0xa3, 0x05,
+	0x48, 0x62, 0xdb, 0xb6, 0xec, 0x5c, 0x6c, 0x49, 0x5a, 0x96, 0x35, 0x6f, 0xa0, 0x7e, 0x4f, 0x82,
+	0x14, 0xa3, 0x9c, 0x05, 0xb9, 0xde, 0x28, 0x34, 0xf6, 0xea, 0xcd, 0xda, 0x1d, 0xe5, 0x12, 0x52,
+	0x60, 0x9a, 0x0d, 0xcb, 0x9a, 0x56, 0xd3, 0x14, 0x09, 0xcd, 0xc3, 0x2c, 0x83, 0x54, 0x6b, 0x8d,
+	0xad, 0xda, 0x5e, 0xb5, 0xa4, 0xc4, 0x38, 0x60, 0xb1, 0x56, 0xdd, 0xda, 0xae, 0x14, 0x1b, 0x4a,
+	0x1c, 0xcd, 0x42, 0x86, 0x01, 0x37, 0xf7, 0xea, 0xf7, 0x94, 0x04, 0x7a, 0x0e, 0xe6, 0x19, 0xa0,
+	0x52, 0x6d, 0x94, 0xb5, 0x6a, 0x61, 0x9b, 0x50, 0x55, 0x92, 0x08, 0xc1, 0x4c, 0x30, 0xb1, 0x5f,
+	0xd8, 0xae, 0x94, 0x94, 0x94, 0xba, 0x0c, 0xb1, 0x8a, 0x81, 0x10, 0x24, 0xba, 0x7a, 0x07, 0x53,
+	0x31, 0x65, 0x8d, 0xfe, 0x46, 0x33, 0x10, 0x33, 0x0d, 0x26, 0x40, 0xcc, 0x34, 0xd4, 0x3f, 0x4a,
+	0x30, 0x55, 0xd4, 0x5d, 0xbd, 0x6d, 0x1d, 0x45, 0xe2, 0x2f, 0x41, 0xc6, 0xc0, 0x4e, 0xcb, 0x36,
+	0xa9, 0x82, 0xd9, 0x87, 0x3c, 0x08, 0xe5, 0x21, 0xdd, 0xb6, 0x5a, 0x54, 0xe7, 0xb9, 0x38, 0x9d,
+	0x0e, 0xc6, 0x68, 0x13, 0xa0, 0xa7, 0xdb, 0x7a, 0x07, 0xbb, 0xd8, 0x76, 0x72, 0x89, 0xa5, 0xf8,
+	0x72, 0x66, 0x43, 0xe5, 0xd4, 0xcd, 0x56, 0x5e, 0xdd, 0x0d, 0x90, 0xca, 0x5d, 0xd7, 0x3e, 0xd7,
+	0xb8, 0xaf, 0xf2, 0xef, 0xc2, 0x6c, 0x68, 0x1a, 0x29, 0x10, 0x3f, 0xc1, 0xe7, 0x8c, 0x4f, 0xf2,
+	0x93, 0x98, 0xe6, 0xbe, 0xde, 0x3e, 0xc5, 0xbe, 0x69, 0xe8, 0xe0, 0xed, 0xd8, 0x5b, 0x92, 0xfa,
+	0x7f, 0xb0, 0x50, 0xb4, 0xb1, 0xee, 0x62, 0xb6, 0x16, 0xb3, 0x30, 0xba, 0x09, 0x53, 0x2d, 0x0f,
+	0x42, 0xe9, 0x64, 0x36, 0xd0, 0x20, 0x5f, 0x9a, 0x8f, 0x82, 0x2e, 0x43, 0xaa, 0x65, 0x59, 0x27,
+	0xa6, 0xbf, 0x00, 0x1b, 0xa9, 0xff, 0x03, 0x73, 0xb7, 0xb0, 0x1b, 0x22, 0x1d, 0xa5, 0xc7, 0x61,
+	0x04, 0x5c, 0x40, 0x3c, 0x01, 0xa7, 0x67, 0x75, 0x1d, 0x3c, 0x21, 0x73, 0xeb, 0x81, 0x43, 0xc7,
+	0x28, 0x72, 0x6e, 0x98, 0x43, 0xfb, 0x9e, 0xac, 0xbe, 0x06, 0xf3, 0xdb, 0xa6, 0xe3, 0x2f, 0xeb,
+	0xf8, 0x8c, 0xf7, 0x99, 0x94, 0x04, 0x26, 0x2d, 0x98, 0x2f, 0xb4, 0x5d, 0x6c, 0x8f, 0x21, 0x27,
+	0xc7, 0x79, 0x6c, 0x12, 0xb5, 0xc6, 0x85, 0x05, 0xdf, 0x03, 0x54, 0xb2, 0xad, 0xde, 0x23, 0xe8,
+	0xf5, 0x67, 0x09, 0x48, 0x97, 0x74, 0x57, 0x3f, 0xd0, 0x1d, 0x8c, 0xae, 0x51, 0xa7, 0xf7, 0x34,
+	0x99, 0xe5, 0xf8, 0xa9, 0x18, 0x64, 0x0f, 0xa0, 0x45, 0x48, 0x39, 0xf8, 0xe3, 0x26, 0xdb, 0x17,
+	0x09, 0x2d, 0xe9, 0xe0, 0x8f, 0x2b, 0xc6, 0x48, 0xc7, 0x2e, 0x46, 0x38, 0xf6, 0x8b, 0x1c, 0x65,
+	0x7f, 0xe9, 0x51, 0x9e, 0x8d, 0xf6, 0x61, 0xce, 0x39, 0x77, 0x5c, 0xdc, 0x69, 0x72, 0xb4, 0x92,
+	0x94, 0xd6, 0xab, 0x51, 0xb4, 0xea, 0x14, 0x39, 0x4c, 0x51, 0x71, 0x42, 0x60, 0x74, 0x03, 0x32,
+	0x2d, 0xea, 0xf2, 0x4d, 0xd7, 0xec, 0xe0, 0x5c, 0x6a, 0x49, 0x5a, 0x8e, 0x6b, 0xe0, 0x81, 0x1a,
+	0x26, 0x35, 0x12, 0x6a, 0xeb, 0x8e, 0xdb, 0xec, 0x58, 0x86, 0x79, 0x68, 0x62, 0xc3, 0xc3, 0x9b,
+	0xa2, 0x78, 0x0a, 0x99, 0xd9, 0x61, 0x13, 0x14, 0x7b, 0x19, 0x28, 0xac, 0xa9, 0xb7, 0x5a, 0xd8,
+	0x71, 0x3c, 0xdc, 0x34, 0xc5, 0x9d, 0x21, 0xf0, 0x02, 0x05, 0x53, 0xcc, 0x6b, 0xc0, 0x56, 0x31,
+	0x9a, 0x07, 0xe7, 0x39, 0x99, 0xea, 0x4c, 0x66, 0x90, 0xcd, 0x73, 0xc2, 0x57, 0xb0, 0xe2, 0xc1,
+	0x79, 0x0e, 0xe8, 0x3c, 0xf8, 0xa0, 0xcd, 0xf3, 0x47, 0xdc, 0xea, 0xf9, 0x22, 0x2c, 0x46, 0xaa,
+	0x68, 0xa2, 0x78, 0xf1, 0x10, 0x16, 0xbd, 0x78, 0xe1, 0xab, 0xdd, 0xf7, 0xbe, 0x9c, 0xb8, 0x27,
+	0xe5, 0xbe, 0x17, 0xaf, 0x41, 0xda, 0x60, 0xc8, 0xcc, 0xe9, 0xe7, 0x23, 0xcc, 0xa7, 0x05, 0x48,
+	0x43, 0xdd, 0xfe, 0x07, 0x12, 0x2c, 0xd0, 0x8d, 0x36, 0xfe, 0xda, 0xd7, 0x82, 0x78, 0x1e, 0xe9,
+	0xda, 0x3c, 0x6b, 0xf1, 0xc9, 0x58, 0x4b, 0x08, 0xac, 0x61, 0x1a, 0xa7, 0x1e, 0x1b, 0x5f, 0xc3,
+	0x34, 0x70, 0x06, 0xf3, 0xc2, 0x32, 0x2c, 0x1e, 0xf2, 0x62, 0x48, 0xe3, 0x88, 0x31, 0x79, 0x48,
+	0xfc, 0x52, 0x82, 0x05, 0x12, 0x13, 0x7d, 0x62, 0xce, 0xc5, 0x32, 0x0e, 0x89, 0x3d, 0xe8, 0x05,
+	0x98, 0x26, 0xb1, 0xa9, 0xd9, 0xd3, 0x5d, 0x17, 0xdb, 0x7e, 0xf0, 0xc8, 0x10, 0xd8, 0xae, 0x07,
+	0x42, 0x2f, 0xc3, 0x0c, 0x3e, 0x6b, 0xb5, 0x4f, 0x0d, 0xec, 0xed, 0x7d, 0x87, 0xaa, 0x3b, 0xad,
+	0x65, 0x19, 0x94, 0x7a, 0xb0, 0x43, 0x56, 0x38, 0x34, 0x71, 0xdb, 0xf0, 0xc2, 0x82, 0xac, 0xb1,
+	0x11, 0xf9, 0x3c, 0xd8, 0x49, 0x8e, 0xd9, 0x6d, 0xf9, 0x9b, 0x3c, 0xeb, 0x43, 0xeb, 0x04, 0xa8,
+	0x7e, 0x03, 0xe6, 0x49, 0x18, 0x7d, 0xd2, 0x56, 0x23, 0xbb, 0xa9, 0x77, 0x6a, 0x1f, 0x61, 0x26,
+	0x84, 0x37, 0x50, 0x6b, 0x90, 0xd9, 0x22, 0xec, 0xd6, 0x5b, 0xc7, 0xb8, 0xa3, 0x47, 0x46, 0x6f,
+	0x04, 0x09, 0xf7, 0xbc, 0xe7, 0xeb, 0x8f, 0xfe, 0xa6, 0xdc, 0x59, 0x9d, 0x0e, 0xee, 0xba, 0x6c,
+	0x15, 0x7f, 0xa8, 0xfe, 0x43, 0x02, 0xb9, 0x8e, 0xed, 0x12, 0xae, 0x74, 0x0f, 0x2d, 0xb4, 0xcc,
+	0xbe, 0xf5, 0x92, 0xb8, 0x05, 0x8e, 0xdb, 0x3a, 0xb6, 0x0d, 0xdc, 0x38, 0xef, 0x61, 0x46, 0xd1,
+	0x5f, 0x39, 0xc6, 0xad, 0xbc, 0x02, 0x8a, 0x83, 0x6d, 0x53, 0x6f, 0x9b, 0x0f, 0x69, 0x44, 0xdf,
+	0x36, 0x0f, 0xd8, 0x72, 0x03, 0x70, 0x54, 0x8a, 0x08, 0xf6, 0x2f, 0x89, 0xeb, 0x79, 0x3c, 0x3d,
+	0xc9, 0x3c, 0xe6, 0x4d, 0x48, 0xd6, 0x6c, 0x03, 0xdb, 0xe4, 0xa3, 0x96, 0xd5, 0xf6, 0x3f, 0x6a,
+	0x59, 0x6d, 0x74, 0x15, 0x64, 0xdd, 0x69, 0xe1, 0xae, 0x61, 0x76, 0xbd, 0x53, 0x37, 0xad, 0xf5,
+	0x01, 0xea, 0x9f, 0x93, 0x30, 0x57, 0x77, 0x2d, 0x5b, 0x3f, 0xc2, 0x25, 0x96, 0xb6, 0x59, 0x36,
+	0x5a, 0x81, 0x44, 0xcb, 0x6a, 0x93, 0x14, 0x98, 0x48, 0x73, 0x99, 0x93, 0x86, 0xb3, 0x99, 0x46,
+	0x71, 0xd0, 0x5b, 0x90, 0x31, 0xbb, 0xbd, 0x53, 0x77, 0xcb, 0xb2, 0x3b, 0xba, 0x67, 0x95, 0x19,
+	0xe1, 0x93, 0x4a, 0x7f, 0x56, 0xe3, 0x51, 0xd1, 0x32, 0xcc, 0x72, 0xc3, 0xaa, 0xde, 0xf1, 0x5c,
+	0x44, 0xd6, 0xc2, 0x60, 0xf4, 0xdf, 0x30, 0x6d, 0x9d, 0xba, 0xfd, 0x45, 0x92, 0x74, 0x91, 0xe7,
+	0xb8, 0x45, 0x6a, 0xdc, 0xb4, 0x26, 0x20, 0x13, 0x63, 0xf2, 0x63, 0xba, 0x4e, 0xca, 0x33, 0x66,
+	0x18, 0x8e, 0xae, 0x03, 0x74, 0x4f, 0x3b, 0x9b, 0xa7, 0xad, 0x13, 0xec, 0x3a, 0xf4, 0xcc, 0x4b,
+	0x6a, 0x1c, 0x04, 0x6d, 0x80, 0xec, 0x10, 0xff, 0x21, 0xf6, 0xa4, 0xc7, 0x5c, 0x66, 0x63, 0x21,
+	0xca, 0xd6, 0x5a, 0x1f, 0x8d, 0xd0, 0x3c, 0xa0, 0x9f, 0x17, 0x89, 0x4a, 0x65, 0xba, 0x55, 0x39,
+	0x08, 0xba, 0x09, 0x69, 0xc7, 0xb2, 0xbd, 0x59, 0xa0, 0x0a, 0x57, 0x78, 0xc1, 0x88, 0x59, 0xb5,
+	0x00, 0x03, 0x6d, 0x0b, 0xee, 0x96, 0xa1, 0xf8, 0x37, 0x79, 0x16, 0xc2, 0xc6, 0x1c, 0x99, 0x64,
+	0x34, 0xa3, 0x92, 0x8c, 0x69, 0x4a, 0x74, 0x63, 0x24, 0xd1, 0x31, 0xb3, 0x8d, 0xaf, 0xc5, 0xa1,
+	0xfd, 0xb7, 0x24, 0x24, 0x1b, 0xfa, 0x41, 0x7b, 0x82, 0x54, 0x2f, 0xce, 0xa7, 0x7a, 0x37, 0x21,
+	0xe6, 0x18, 0xd4, 0x35, 0x33, 0x1b, 0x57, 0x47, 0x69, 0x45, 0x8b, 0x39, 0x06, 0x7a, 0x07, 0xb2,
+	0x3d, 0xdd, 0x76, 0x4d, 0x12, 0x1f, 0xee, 0xe0, 0x73, 0x3f, 0x67, 0x1b, 0xb6, 0x89, 0x44, 0x64,
+	0xe2, 0x60, 0x2e, 0x61, 0x95, 0x04, 0xa8, 0x5c, 0x6a, 0x20, 0x78, 0x35, 0xfc, 0x39, 0xad, 0x8f,
+	0x86, 0xde, 0x13, 0x5c, 0x62, 0x8a, 0x2e, 0xb7, 0x14, 0xfe, 0x68, 0xa4, 0x1b, 0xd4, 0xa3, 0xdc,
+	0x20, 0x4d, 0x09, 0xbd, 0x32, 0x40, 0x68, 0xdc, 0x44, 0x93, 0xcf, 0x90, 0xe5, 0x50, 0x86, 0x1c,
+	0x4a, 0x42, 0x61, 0xcc, 0x24, 0x34, 0x33, 0x41, 0x12, 0x3a, 0x3d, 0x46, 0x12, 0x9a, 0xbd, 0x20,
+	0x09, 0x9d, 0x09, 0x27, 0xa1, 0xe4, 0x6c, 0x75, 0xa8, 0xe1, 0x9a, 0xf7, 0xb1, 0xed, 0x10, 0xd1,
+	0x66, 0x69, 0x90, 0xc8, 0x7a, 0xd0, 0x7d, 0x0f, 0xf8, 0xb5, 0x70, 0xfb, 0xcf, 0x25, 0xc8, 0x50,
+	0x8b, 0xb1, 0x23, 0x36, 0x07, 0x53, 0x3e, 0xcf, 0x12, 0xe5, 0xd9, 0x1f, 0x06, 0xe1, 0x3e, 0x36,
+	0x46, 0xb8, 0x0f, 0x59, 0x2e, 0x3e, 0x60, 0x39, 0x51, 0xc3, 0x89, 0x90, 0x86, 0xd5, 0xcf, 0x24,
+	0x40, 0x5e, 0x0a, 0x4d, 0x79, 0xbb, 0x38, 0xeb, 0x50, 0x21, 0x69, 0x1c, 0x34, 0x87, 0x25, 0x1e,
+	0x09, 0xe3, 0xa0, 0x62, 0xa0, 0x57, 0x20, 0x49, 0xb7, 0x03, 0xcb, 0x62, 0x95, 0xb0, 0xcf, 0x6a,
+	0xde, 0xf4, 0xd0, 0xfc, 0xf5, 0x5b, 0x12, 0xcc, 0xde, 0xc2, 0xee, 0x63, 0xe4, 0xc8, 0x8b, 0x34,
+	0xf1, 0x8b, 0x73, 0x25, 0x91, 0x91, 0x36, 0x28, 0x7d, 0x3e, 0x58, 0x7a, 0x1b, 0x08, 0x27, 0x8d,
+	0x16, 0x6e, 0xf2, 0xac, 0xf6, 0x0b, 0x09, 0xe6, 0x48, 0x56, 0x4b, 0xc9, 0x38, 0x8f, 0x47, 0xf0,
+	0x61, 0x59, 0x60, 0x3f, 0x59, 0x4d, 0x5c, 0x90, 0xac, 0x26, 0xa3, 0x92, 0xd5, 0x1f, 0x49, 0xa0,
+	0x90, 0x6c, 0xf5, 0x99, 0x9b, 0xa8, 0x9f, 0xce, 0x26, 0xf9, 0x74, 0xf6, 0xf3, 0x18, 0x64, 0x09,
+	0x7f, 0x3d, 0x6c, 0xd4, 0x0e, 0x3e, 0xc2, 0x2d, 0x17, 0xbd, 0x0e, 0x89, 0x13, 0xb3, 0x6b, 0xb0,
+	0x0c, 0xf4, 0x1a, 0x5f, 0x91, 0xf0, 0x78, 0xab, 0x77, 0xcc, 0xae, 0xa1, 0x51, 0xd4, 0x8b, 0x12,
+	0xec, 0x40, 0xa8, 0xf8, 0x70, 0xa1, 0xae, 0x80, 0x6c, 0xd8, 0x56, 0xcf, 0xdb, 0x9c, 0x09, 0xaa,
+	0xc9, 0x34, 0x01, 0xd0, 0xad, 0x79, 0x03, 0x32, 0xf8, 0xac, 0x67, 0xda, 0x6c, 0xef, 0x7a, 0x8a,
+	0x06, 0x0f, 0xe4, 0xef, 0x5d, 0xc3, 0x63, 0x8e, 0xec, 0x5d, 0x2f, 0x49, 0x92, 0x19, 0x64, 0xf3,
+	0x5c, 0x5d, 0x83, 0x04, 0xe1, 0x16, 0xcd, 0x41, 0xb6, 0xa4, 0xd5, 0x76, 0x77, 0xcb, 0xa5, 0x66,
+	0xa3, 0xb0, 0xb9, 0x5d, 0x56, 0x2e, 0xa1, 0x05, 0x50, 0x7c, 0x50, 0xa9, 0xd0, 0x28, 0x6c, 0x16,
+	0xea, 0x65, 0x45, 0x52, 0x3f, 0x02, 0x44, 0xab, 0x26, 0x8f, 0xc2, 0x13, 0x75, 0x30, 0xb5, 0x0d,
+	0xd9, 0xbd, 0x2e, 0xe1, 0xf5, 0xe2, 0x65, 0x42, 0x6d, 0x4e, 0xf4, 0x3c, 0xa4, 0xbb, 0xf8, 0x41,
+	0x93, 0x96, 0x01, 0xac, 0xaa, 0xe8, 0xe2, 0x07, 0x55, 0xb1, 0x83, 0x24, 0x6e, 0xd4, 0x2f, 0x25,
+	0x98, 0xa3, 0xc5, 0xf8, 0xd3, 0x73, 0xc8, 0x20, 0x0e, 0x24, 0xc6, 0x0d, 0x72, 0x49, 0xc1, 0x71,
+	0x6f, 0x40, 0xe6, 0xb4, 0x67, 0x90, 0xc8, 0xdd, 0xd1, 0x9d, 0x93, 0x5c, 0xca, 0x4b, 0x44, 0x3d,
+	0xd0, 0x8e, 0xee, 0x9c, 0xa8, 0xdf, 0x89, 0xc1, 0xa2, 0x17, 0xeb, 0x2b, 0xdd, 0x96, 0xd5, 0xe9,
+	0xe9, 0xae, 0x79, 0x60, 0xb6, 0x4d, 0xf7, 0x1c, 0xfd, 0x97, 0xe0, 0xcb, 0x2f, 0xf3, 0x39, 0x50,
+	0x14, 0x3e, 0xef, 0xd3, 0x94, 0x9b, 0xf6, 0x69, 0xa7, 0xdb, 0x2f, 0x83, 0xc9, 0x88, 0xe8, 0xdc,
+	0x6a, 0x1b, 0x4d, 0x5a, 0xa4, 0x31, 0x9d, 0x5b, 0x6d, 0x83, 0xe6, 0x33, 0xcc, 0x1c, 0x74, 0x2a,
+	0x11, 0x98, 0xa3, 0xc1, 0xca, 0xbf, 0x0e, 0x76, 0x1c, 0xfd, 0xc8, 0x17, 0xce, 0x1f, 0xaa, 0x35,
+	0xe6, 0x9b, 0x39, 0x58, 0xa8, 0x54, 0x8b, 0xb5, 0x9d, 0xdd, 0x42, 0xa3, 0xb2, 0xb9, 0x5d, 0x6e,
+	0x32, 0xaf, 0x54, 0x2e, 0xa1, 0xcb, 0x80, 0x84, 0x99, 0x9d, 0xda, 0x7e, 0xb9, 0xa4, 0x48, 0x68,
+	0x11, 0xe6, 0x04, 0x78, 0xe3, 0xde, 0x6e, 0x59, 0x89, 0xa9, 0xbf, 0x26, 0x16, 0x36, 0x8c, 0x22,
+	0x65, 0xd7, 0x79, 0x2a, 0x16, 0xf6, 0xcf, 0xe1, 0xc4, 0x18, 0xe7, 0x30, 0x65, 0xc4, 0x69, 0xe9,
+	0x86, 0x1f, 0x88, 0xfc, 0x21, 0x67, 0xff, 0x94, 0xe0, 0xb2, 0xbf, 0x95, 0x60, 0x51, 0xc3, 0xbd,
+	0xb6, 0xde, 0xc2, 0xff, 0x3a, 0x42, 0x7d, 0x3f, 0x06, 0xf3, 0xc5, 0x63, 0xbd, 0x7b, 0xc4, 0x64,
+	0x7a, 0x2a, 0x22, 0xf9, 0x2d, 0x83, 0x04, 0xd7, 0x32, 0x58, 0x0d, 0xfc, 0x3c, 0xb9, 0x24, 0x8d,
+	0x10, 0xd4, 0xf7, 0xff, 0x05, 0x48, 0x1e, 0x9a, 0xb6, 0xe3, 0x52, 0x79, 0xd2, 0x9a, 0x37, 0x20,
+	0x50, 0xfd, 0xd0, 0xc5, 0x36, 0x2d, 0x3d, 0x65, 0xcd, 0x1b, 0xf0, 0x6a, 0x49, 0x0f, 0x53, 0x8b,
+	0x2c, 0x36, 0xb8, 0x25, 0x98, 0xa3, 0x3d, 0xf2, 0x67, 0xab, 0x94, 0xc9, 0xed, 0xf9, 0x0b, 0x09,
+	0x10, 0x1f, 0x57, 0x9f, 0x74, 0x0e, 0x84, 0xaa, 0x30, 0x67, 0x0a, 0xd1, 0xcb, 0xc4, 0x4e, 0x2e,
+	0x3e, 0x50, 0x43, 0x45, 0xc6, 0x39, 0x6d, 0xf0, 0x53, 0xf5, 0x4f, 0x09, 0x90, 0x77, 0xfd, 0x92,
+	0xee, 0x2b, 0xde, 0x2d, 0x5c, 0x86, 0x14, 0x4d, 0xe3, 0x3d, 0x46, 0x64, 0x8d, 0x8d, 0x26, 0x2c,
+	0x44, 0xc5, 0xc6, 0x54, 0x72, 0xa0, 0x31, 0x15, 0x70, 0x39, 0xb2, 0x34, 0xe4, 0xab, 0xb8, 0x54,
+	0xa8, 0x8a, 0x0b, 0xac, 0x32, 0x35, 0xda, 0x2a, 0xa1, 0x9a, 0x21, 0x3d, 0x66, 0xb5, 0x27, 0x4f,
+	0x50, 0xed, 0xc1, 0x18, 0xd5, 0x5e, 0xe6, 0x82, 0x6a, 0x6f, 0x7a, 0xa0, 0xda, 0x5b, 0x85, 0x79,
+	0xb3, 0x7b, 0x8c, 0x6d, 0x93, 0x50, 0x70, 0x8c, 0x26, 0xcb, 0x60, 0xb3, 0xd4, 0x2a, 0x73, 0xc1,
+	0x54, 0xdd, 0xd8, 0x0a, 0x92, 0xd9, 0x50, 0x75, 0x38, 0xf3, 0xf8, 0xab, 0x43, 0xf5, 0x57, 0x12,
+	0xcc, 0x17, 0x0c, 0x23, 0xb0, 0x9f, 0xbf, 0xbd, 0xf3, 0x90, 0x76, 0xc8, 0x4f, 0x92, 0x44, 0x4b,
+	0xd4, 0x9f, 0x82, 0x31, 0xbf, 0xf5, 0x63, 0x43, 0xb6, 0xfe, 0x88, 0xac, 0x72, 0x19, 0xd2, 0xd4,
+	0x92, 0x4d, 0xd3, 0x77, 0xbf, 0x10, 0xda, 0x14, 0x9d, 0xae, 0x18, 0xa4, 0x7f, 0x11, 0x34, 0x34,
+	0x58, 0x24, 0x5c, 0x88, 0xf2, 0x39, 0xad, 0x8f, 0xa6, 0x1a, 0xb0, 0x20, 0x8a, 0xc3, 0x36, 0xfd,
+	0x28, 0x79, 0x26, 0x2f, 0x76, 0x7e, 0x2f, 0xd1, 0xdb, 0x83, 0x01, 0xad, 0x3d, 0x5a, 0x50, 0xe4,
+	0x35, 0x13, 0x1f, 0xa9, 0x99, 0xfe, 0xa6, 0x4e, 0x08, 0x9b, 0xda, 0x8f, 0x9b, 0x49, 0x2e, 0x6e,
+	0xbe, 0x0a, 0x0a, 0x3e, 0x3c, 0xc4, 0x2d, 0xd7, 0xbc, 0x8f, 0x9b, 0x9e, 0xef, 0xb0, 0x73, 0x62,
+	0x36, 0x80, 0x7b, 0x11, 0x49, 0xfd, 0xb1, 0x04, 0x0b, 0xa2, 0x58, 0x4c, 0x7b, 0x82, 0x25, 0xa4,
+	0xb1, 0x2c, 0xf1, 0x15, 0xc2, 0xe7, 0x2a, 0xa4, 0x18, 0x7f, 0xf1, 0x81, 0x63, 0x8f, 0x6b, 0x3e,
+	0x68, 0x0c, 0x4b, 0xfd, 0x49, 0x1c, 0x16, 0x49, 0x49, 0x10, 0x2c, 0xef, 0x3c, 0x03, 0x3b, 0x44,
+	0xd6, 0x75, 0xc3, 0x6e, 0x53, 0x36, 0x02, 0xbb, 0xa5, 0x68, 0x08, 0xcd, 0x47, 0x29, 0x71, 0x9f,
+	0x62, 0x04, 0x36, 0xcd, 0xc1, 0x14, 0xbb, 0xaa, 0xa1, 0xed, 0x38, 0x59, 0xf3, 0x87, 0x11, 0xe5,
+	0x6e, 0x3a, 0xa2, 0xdc, 0x25, 0x9b, 0x9f, 0x38, 0x82, 0xdf, 0x2e, 0xf6, 0x06, 0xc4, 0x55, 0x0e,
+	0x6d, 0xab, 0x43, 0xbb, 0xc4, 0xb2, 0x46, 0x7f, 0x93, 0x5a, 0xc6, 0xb5, 0x68, 0x1f, 0x58, 0xd6,
+	0x62, 0xae, 0x45, 0xc4, 0xe8, 0xd9, 0xf8, 0xd0, 0x3c, 0xa3, 0x6d, 0x5c, 0x59, 0x63, 0x23, 0xd2,
+	0x85, 0x36, 0x70, 0x70, 0x0f, 0x90, 0xa5, 0xce, 0xc4, 0x41, 0xd8, 0xc5, 0x4a, 0x4f, 0x6f, 0xb9,
+	0xb9, 0x19, 0x76, 0x54, 0x7b, 0x43, 0xf5, 0xdf, 0x60, 0x36, 0x24, 0x67, 0x3f, 0x36, 0x49, 0x1e,
+	0x7b, 0x74, 0xa0, 0xfe, 0x5d, 0x82, 0x45, 0x92, 0x74, 0x3c, 0x3b, 0xdb, 0x6e, 0x08, 0x7b, 0x6c,
+	0x3c, 0x5b, 0x0d, 0x2b, 0x97, 0x02, 0x13, 0xa4, 0x78, 0x13, 0xf4, 0xd5, 0x3b, 0xc5, 0xab, 0x57,
+	0xfd, 0xb9, 0x04, 0x39, 0x7e, 0x1b, 0x92, 0xe2, 0xf1, 0x6b, 0xe3, 0xda, 0x57, 0x40, 0xee, 0xe8,
+	0x67, 0xa4, 0xb7, 0xeb, 0x3a, 0x54, 0xca, 0xa4, 0x96, 0xee, 0xe8, 0x67, 0x84, 0x59, 0x47, 0x6d,
+	0xc1, 0xf3, 0x11, 0x8c, 0xb3, 0x20, 0x12, 0x28, 0x41, 0xe2, 0x95, 0x30, 0x79, 0xf0, 0x6d, 0x80,
+	0xa2, 0x61, 0xc7, 0x6a, 0xdf, 0xc7, 0x15, 0x63, 0xf2, 0xfa, 0x7c, 0x58, 0xc9, 0xff, 0xcb, 0x38,
+	0xcc, 0x71, 0x64, 0x19, 0xcf, 0x6f, 0x08, 0xc5, 0xea, 0x0b, 0x02, 0x6f, 0x21, 0x5c, 0xbe, 0x50,
+	0x7d, 0x3a, 0xd1, 0x9e, 0xbf, 0xab, 0x4e, 0x8e, 0x73, 0x57, 0x1d, 0xe4, 0x58, 0xa9, 0xd1, 0x39,
+	0x96, 0x10, 0xee, 0xa7, 0x26, 0x0d, 0xf7, 0xe9, 0x31, 0xed, 0xe8, 0x57, 0xd9, 0x0a, 0x4c, 0xdf,
+	0xa9, 0x54, 0x4b, 0xcd, 0xbd, 0xea, 0x9d, 0x6a, 0xed, 0xc3, 0xaa, 0x72, 0x89, 0xf4, 0x84, 0x28,
+	0xa4, 0xdf, 0xfd, 0x41, 0x33, 0x00, 0x14, 0xe4, 0xf5, 0x88, 0x62, 0xe4, 0x2d, 0x1a, 0x1d, 0xef,
+	0x16, 0xb4, 0x46, 0xa5, 0x51, 0xa9, 0x55, 0x95, 0xb8, 0xba, 0x05, 0xd9, 0x4d, 0xbd, 0x75, 0x72,
+	0x1a, 0x74, 0x6d, 0x48, 0xca, 0x76, 0x7c, 0xda, 0x3d, 0x69, 0x3a, 0xe6, 0x43, 0xef, 0xd8, 0xcf,
+	0x6a, 0x32, 0x85, 0xd4, 0xcd, 0x87, 0xc3, 0x5f, 0xf4, 0xbc, 0x0f, 0x19, 0x8f, 0x4e, 0x91, 0xa0,
+	0x92, 0x48, 0x49, 0x34, 0x48, 0xbf, 0x9f, 0xd6, 0xe8, 0x6f, 0x02, 0xa3, 0x34, 0x63, 0x34, 0xe0,
+	0xd2, 0xdf, 0x68, 0x1e, 0x92, 0xee, 0x59, 0xff, 0xc2, 0x27, 0xe1, 0x9e, 0x55, 0x8c, 0x95, 0x2f,
+	0xbc, 0x9b, 0x64, 0xef, 0x96, 0x98, 0xbe, 0xd3, 0x2b, 0x6b, 0xa5, 0x72, 0xb3, 0xb8, 0x57, 0x6f,
+	0xd4, 0x76, 0x94, 0x4b, 0xa4, 0x61, 0xe0, 0x41, 0xb6, 0x0b, 0xff, 0x7b, 0xaf, 0x59, 0xaf, 0xec,
+	0xec, 0x6e, 0x33, 0x71, 0x3d, 0x70, 0x61, 0x5f, 0xab, 0x29, 0xb1, 0xfe, 0xf8, 0xfd, 0x3a, 0x11,
+	0x95, 0xbe, 0xff, 0xa3, 0xe3, 0x9a, 0x56, 0x54, 0x12, 0xf4, 0x0d, 0x1f, 0x1d, 0x6a, 0xe5, 0x5b,
+	0xe5, 0xbb, 0x4a, 0xb2, 0xbf, 0x50, 0xe3, 0xb6, 0x56, 0xd9, 0x6a, 0x28, 0x29, 0xa2, 0x53, 0x0f,
+	0xb2, 0x5b, 0xd0, 0x3e, 0xd8, 0x2b, 0x37, 0x94, 0xa9, 0x3e, 0x91, 0x62, 0x7d, 0x5f, 0x49, 0xaf,
+	0x7c, 0x08, 0x19, 0xee, 0x7a, 0x95, 0xcc, 0x56, 0xb6, 0xfa, 0x8c, 0xce, 0x42, 0xa6, 0xb2, 0xd5,
+	0xac, 0x97, 0x3f, 0xd8, 0x2b, 0x57, 0x8b, 0x84, 0xc5, 0x0c, 0x4c, 0x55, 0xb6, 0x9a, 0x8d, 0xf2,
+	0xdd, 0x86, 0x12, 0x63, 0x83, 0xdb, 0x95, 0xfd, 0xb2, 0x12, 0x27, 0xcc, 0x56, 0xb6, 0x82, 0x75,
+	0x12, 0x2b, 0xff, 0x0f, 0xd3, 0xfc, 0x95, 0x2a, 0xa1, 0x5c, 0x13, 0x29, 0xd7, 0x38, 0xca, 0x31,
+	0xc2, 0x6a, 0x6d, 0xab, 0x59, 0xb9, 0x55, 0xad, 0x69, 0xe5, 0xe6, 0x9d, 0xf2, 0x3d, 0x25, 0x4e,
+	0xe8, 0xd7, 0x18, 0xfd, 0x04, 0xa1, 0x5f, 0xeb, 0xd3, 0x4f, 0xae, 0x14, 0x41, 0x0e, 0xee, 0xb2,
+	0xc8, 0xc7, 0x0d, 0xd2, 0x75, 0x69, 0xee, 0x14, 0xaa, 0x85, 0x5b, 0xb4, 0x59, 0x83, 0x60, 0xc6,
+	0x03, 0x95, 0xef, 0x7a, 0xef, 0x19, 0x15, 0x89, 0x2c, 0xea, 0xc1, 0x2a, 0xd5, 0x52, 0xf9, 0xae,
+	0x12, 0x5b, 0x29, 0x83, 0x52, 0x0f, 0x5f, 0xc7, 0x13, 0x05, 0x6d, 0xf7, 0x19, 0x25, 0xef, 0x1f,
+	0xb7, 0x23, 0x0c, 0xb5, 0x1d, 0xf0, 0x12, 0xdb, 0xf8, 0xcd, 0x02, 0xc8, 0x3b, 0xbe, 0xe3, 0xa3,
+	0x1a, 0x64, 0x85, 0x27, 0x81, 0x88, 0x7f, 0x01, 0x1a, 0xf5, 0x58, 0x30, 0xcf, 0xf7, 0x76, 0x23,
+	0x9e, 0xeb, 0x61, 0x80, 0x3e, 0x14, 0x5d, 0x1d, 0x82, 0x3c, 0x0e, 0x29, 0x35, 0xff, 0xcd, 0xdf,
+	0xfd, 0xe5, 0xf3, 0xd8, 0x02, 0x42, 0x6b, 0xf7, 0x37, 0xd6, 0x58, 0xb4, 0x5c, 0xfb, 0x84, 0x04,
+	0xe5, 0x4f, 0xd1, 0x3d, 0x98, 0xe6, 0x5f, 0xed, 0xa1, 0xeb, 0x1c, 0xa9, 0x88, 0xe7, 0x7c, 0xf9,
+	0x88, 0xa7, 0x77, 0xea, 0x3c, 0xa5, 0x9f, 0x45, 0x19, 0x8e, 0xfe, 0xba, 0x84, 0x76, 0x60, 0x9a,
+	0x7f, 0xe1, 0x27, 0x90, 0x8e, 0x78, 0xfa, 0x77, 0x91, 0x42, 0xb6, 0x20, 0xc3, 0xbd, 0xdf, 0x43,
+	0xe1, 0xd6, 0x78, 0x88, 0xd8, 0xd0, 0xa0, 0x84, 0x34, 0x98, 0xf1, 0x1f, 0x63, 0x1d, 0xe8, 0x2e,
+	0x09, 0x96, 0x4b, 0x03, 0xa6, 0x0a, 0xbd, 0x6e, 0xc9, 0x5f, 0x17, 0x59, 0x1b, 0x78, 0x4b, 0xd4,
+	0x83, 0x0c, 0x07, 0x46, 0xd7, 0x86, 0xa1, 0x8f, 0x45, 0x4d, 0x55, 0xa9, 0x3e, 0xaf, 0xa2, 0x3c,
+	0xd1, 0xa7, 0x71, 0xb0, 0xf6, 0x09, 0xd3, 0xe9, 0xa7, 0x6b, 0x9f, 0x98, 0xc6, 0xaa, 0x67, 0x37,
+	0x1d, 0xb2, 0xc2, 0xcb, 0x22, 0xc1, 0xdf, 0xa2, 0xde, 0x1c, 0xe5, 0xa3, 0x4e, 0x0c, 0x35, 0x47,
+	0x97, 0x42, 0x48, 0x09, 0x2f, 0xb5, 0x2e, 0xa1, 0xdb, 0x30, 0xcd, 0xbf, 0xf4, 0x11, 0xec, 0x17,
+	0xf1, 0x04, 0x68, 0x84, 0xca, 0x77, 0x21, 0x2b, 0x3c, 0x41, 0x13, 0x98, 0x8d, 0x7a, 0x9c, 0x76,
+	0xa1, 0xc2, 0x2b, 0x90, 0xe1, 0xae, 0x03, 0x05, 0x85, 0x0f, 0x5e, 0x13, 0xe6, 0xaf, 0x88, 0xd4,
+	0xc4, 0x26, 0xd1, 0x03, 0x48, 0xfb, 0x30, 0x94, 0x8f, 0x44, 0xbc, 0x98, 0x88, 0xba, 0x41, 0xf5,
+	0x78, 0x13, 0xad, 0x10, 0x3d, 0xd2, 0xa3, 0x95, 0xb7, 0x1a, 0xcd, 0x10, 0x3c, 0xc3, 0x71, 0x26,
+	0x3c, 0x06, 0xe8, 0x5f, 0xa3, 0x09, 0x3b, 0x7c, 0xe0, 0x76, 0x2d, 0x3f, 0x70, 0x80, 0xab, 0xcb,
+	0x74, 0x45, 0x15, 0x2d, 0x5d, 0xb4, 0xe2, 0xba, 0x84, 0x36, 0x41, 0x0e, 0x6e, 0xc1, 0xd0, 0x95,
+	0x90, 0x19, 0x05, 0x21, 0x87, 0xdb, 0xb0, 0x02, 0xd0, 0xef, 0xb0, 0x09, 0xdc, 0x0e, 0x5c, 0x68,
+	0xe4, 0xaf, 0x0d, 0x99, 0x0d, 0x8c, 0x07, 0xfd, 0x16, 0xb9, 0x48, 0x2a, 0xdc, 0x39, 0xbf, 0x88,
+	0xd4, 0x07, 0x30, 0x23, 0x36, 0xa7, 0x85, 0xcd, 0x1c, 0xd9, 0xb7, 0xbe, 0x88, 0xe4, 0x0e, 0x4c,
+	0xf3, 0xad, 0x61, 0xc1, 0xed, 0x23, 0x7a, 0xc6, 0x63, 0x08, 0xdb, 0x6f, 0xa9, 0x0a, 0xc2, 0x0e,
+	0x74, 0x5a, 0x2f, 0x22, 0x75, 0x1b, 0x32, 0xdc, 0xbd, 0x98, 0xe0, 0xf4, 0x83, 0xf7, 0x65, 0x82,
+	0x29, 0x85, 0xbb, 0xc3, 0x75, 0x09, 0xbd, 0x03, 0x29, 0xef, 0xd6, 0x0b, 0xf1, 0x58, 0xc2, 0x45,
+	0xd8, 0x08, 0x57, 0xa8, 0xc1, 0x34, 0xdf, 0x79, 0x11, 0x03, 0xfb, 0x60, 0x87, 0x29, 0x7f, 0x63,
+	0xe8, 0x3c, 0x13, 0xec, 0x2e, 0xbd, 0x33, 0xd9, 0xd1, 0xbb, 0xe7, 0xc1, 0x9c, 0xf3, 0xc8, 0x54,
+	0x97, 0xa5, 0x75, 0x09, 0x7d, 0x57, 0x82, 0x69, 0xbe, 0x4e, 0x41, 0xa1, 0xc0, 0x32, 0x92, 0x6a,
+	0x54, 0x83, 0x44, 0x7d, 0x87, 0xee, 0xbb, 0xff, 0x44, 0xff, 0x41, 0xf6, 0x5d, 0x90, 0x14, 0x0f,
+	0xdd, 0xed, 0x7e, 0xe2, 0xef, 0x8d, 0xd1, 0xb7, 0x25, 0x98, 0x11, 0x1b, 0x19, 0x82, 0xc3, 0x46,
+	0xf6, 0x38, 0xf2, 0x91, 0x19, 0xb9, 0xfa, 0x2e, 0x65, 0xe4, 0x4d, 0xf4, 0x86, 0xc0, 0x88, 0x33,
+	0x26, 0x27, 0xeb, 0x12, 0xfa, 0xa1, 0x44, 0xff, 0xd1, 0x40, 0xac, 0xe1, 0xd0, 0x8b, 0x43, 0x14,
+	0xc0, 0x97, 0xa6, 0xf9, 0x97, 0x46, 0x23, 0x0d, 0x53, 0x15, 0x59, 0x79, 0x5c, 0x06, 0xd1, 0x36,
+	0xcc, 0x88, 0x6d, 0x01, 0x41, 0x53, 0x91, 0x1d, 0x83, 0x11, 0x3e, 0x8b, 0x41, 0x0e, 0xea, 0x38,
+	0x21, 0x04, 0x86, 0x0b, 0xcc, 0xfc, 0xd5, 0x51, 0xa5, 0x9f, 0x7a, 0x8d, 0xca, 0xf4, 0x1c, 0x5a,
+	0x24, 0x32, 0x99, 0x86, 0x78, 0x36, 0x7f, 0x4a, 0x36, 0x96, 0x57, 0x50, 0x08, 0x1b, 0x4b, 0xa8,
+	0x55, 0xf2, 0x97, 0x07, 0x66, 0x68, 0xf5, 0xb1, 0x2e, 0x6d, 0xfe, 0x41, 0xfa, 0xac, 0xf0, 0x53,
+	0x09, 0x7d, 0x04, 0xe8, 0xb6, 0x79, 0x1f, 0x2f, 0x05, 0xd9, 0xe5, 0x52, 0xa1, 0x67, 0xaa, 0x35,
+	0xb8, 0x1c, 0x82, 0xee, 0xda, 0x16, 0x7d, 0x33, 0xa0, 0x1e, 0xbb, 0x6e, 0xcf, 0x79, 0x7b, 0x6d,
+	0xed, 0xc8, 0x74, 0x8f, 0x4f, 0x0f, 0x56, 0x5b, 0x56, 0x67, 0x4d, 0x3f, 0xb1, 0xda, 0x07, 0xaf,
+	0xaf, 0x1d, 0x77, 0x9c, 0xfb, 0x1b, 0x7a, 0xcf, 0xcc, 0xcf, 0x79, 0x80, 0xf7, 0xbc, 0xff, 0x82,
+	0x22, 0x28, 0x1b, 0xf1, 0xd7, 0x57, 0xd7, 0xed, 0x12, 0x5c, 0xe7, 0x96, 0xd9, 0xad, 0x2c, 0xed,
+	0x6f, 0x2c, 0x95, 0xac, 0xd6, 0x69, 0x07, 0x77, 0xbd, 0xff, 0x79, 0x1a, 0x87, 0x3a, 0xcc, 0xb7,
+	0xac, 0xce, 0x2a, 0x05, 0xf6, 0x65, 0xdb, 0xa4, 0xd9, 0x31, 0xb9, 0x64, 0xc0, 0xbb, 0xd2, 0x41,
+	0x8a, 0xfe, 0xe7, 0xd4, 0xbf, 0xff, 0x73, 0x00, 0x32, 0x4c, 0x5e, 0x66, 0xa3, 0x35, 0x00, 0x00,
 }
@@ -0,0 +1,175 @@
+// Package tlsutil provides TLS configuration helpers shared by hmsv2server and hmsproxy.
+//
+// Certificates are served through a Reloader which re-reads certificate and key files
+// when they change on disk, so certificates can be rotated without restarting the process.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// How often certificate files are checked for changes
+const checkInterval = 10 * time.Second
+
+// Reloader holds a certificate/key pair and reloads it when either file changes.
+type Reloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+	checked time.Time
+}
+
+// NewReloader loads the certificate and key pair and returns a Reloader for it.
+func NewReloader(certFile string, keyFile string) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// load unconditionally reads the certificate and key pair.
+// Should be called with mu held or before Reloader is shared.
+func (r *Reloader) load() error {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load key pair %s/%s: %v", r.certFile, r.keyFile, err)
+	}
+	r.cert = &cert
+	r.modTime = modTime
+	r.checked = time.Now()
+	return nil
+}
+
+// lastModified returns the latest modification time of certificate and key files.
+func (r *Reloader) lastModified() (time.Time, error) {
+	var modTime time.Time
+	for _, name := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return modTime, err
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	return modTime, nil
+}
+
+// certificate returns the current certificate, reloading it if files changed.
+// If reload fails the previous certificate is kept.
+func (r *Reloader) certificate() *tls.Certificate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checked) < checkInterval {
+		return r.cert
+	}
+	r.checked = time.Now()
+	modTime, err := r.lastModified()
+	if err != nil {
+		log.Println("failed to check certificate:", err)
+		return r.cert
+	}
+	if !modTime.After(r.modTime) {
+		return r.cert
+	}
+	if err = r.load(); err != nil {
+		log.Println("failed to reload certificate:", err)
+		return r.cert
+	}
+	log.Println("reloaded certificate", r.certFile)
+	return r.cert
+}
+
+// GetCertificate can be used as tls.Config.GetCertificate
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.certificate(), nil
+}
+
+// GetClientCertificate can be used as tls.Config.GetClientCertificate
+func (r *Reloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.certificate(), nil
+}
+
+// LoadCertPool reads PEM-encoded CA certificates from the file.
+func LoadCertPool(caFile string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return pool, nil
+}
+
+// ServerConfig returns TLS configuration for a server.
+//
+//   certFile, keyFile - server certificate and key, must be non-empty
+//   clientCAFile - if non-empty, clients must present a certificate signed by one
+//                  of the CAs in this file (mutual TLS)
+func ServerConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	reloader, err := NewReloader(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		GetCertificate: reloader.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		pool, err := LoadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// ClientConfig returns TLS configuration for a client.
+//
+//   caFile - CA certificates used to verify the server. If empty, system roots are used
+//   certFile, keyFile - optional client certificate for mutual TLS
+//   serverName - optional override of the server name used for verification
+func ClientConfig(caFile string, certFile string, keyFile string,
+	serverName string) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		pool, err := LoadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("both client certificate and key should be specified")
+		}
+		reloader, err := NewReloader(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = reloader.GetClientCertificate
+	}
+	return config, nil
+}
@@ -0,0 +1,102 @@
+// Package tracing configures OpenTelemetry tracing for the metadata server and proxy.
+//
+// Init installs global tracer provider and W3C trace context propagator, so that
+// instrumented gRPC and HTTP code picks them up automatically.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+// Supported exporters
+const (
+	ExporterNone = ""
+	ExporterOTLP = "otlp"
+	ExporterFile = "file"
+)
+
+// Config describes tracing setup.
+type Config struct {
+	Service  string  // Service name reported in spans
+	Exporter string  // One of ExporterNone, ExporterOTLP or ExporterFile
+	Endpoint string  // OTLP collector host:port, uses OTEL_EXPORTER_OTLP_* variables if empty
+	Insecure bool    // Use plaintext connection to OTLP collector
+	File     string  // Output file for ExporterFile
+	Ratio    float64 // Fraction of traces sampled when there is no sampled parent
+}
+
+// Init sets up global tracer provider and propagator.
+// It returns function which flushes pending spans and stops the exporter.
+// When the exporter is ExporterNone only the propagator is installed, so trace
+// context still flows through the process.
+func Init(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	closeFile := func() error { return nil }
+	switch cfg.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exp, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		exporter = exp
+	case ExporterFile:
+		if cfg.File == "" {
+			return nil, fmt.Errorf("missing trace file")
+		}
+		f, err := os.OpenFile(cfg.File, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, err
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		exporter = exp
+		closeFile = f.Close
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %s", cfg.Exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.Service)))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Ratio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if cerr := closeFile(); err == nil {
+			err = cerr
+		}
+		return err
+	}, nil
+}
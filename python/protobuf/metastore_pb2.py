@@ -22,7 +22,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='metastore.proto',
   package='metastore',
   syntax='proto3',
-  serialized_pb=_b('\n\x0fmetastore.proto\x12\tmetastore\x1a\x1cgoogle/api/annotations.proto\x1a,protoc-gen-swagger/options/annotations.proto\"\xce\x01\n\rRequestStatus\x12/\n\x06status\x18\x01 \x01(\x0e\x32\x1f.metastore.RequestStatus.Status\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"}\n\x06Status\x12\r\n\tSTATUS_OK\x10\x00\x12\x10\n\x0cSTATUS_ERROR\x10\x01\x12\x13\n\x0fSTATUS_NOTFOUND\x10\x02\x12\x13\n\x0fSTATUS_CONFLICT\x10\x03\x12\x0f\n\x0bSTATUS_BUSY\x10\x04\x12\x17\n\x13STATUS_INTERNAL_ERR\x10\x05\"\x1e\n\x02Id\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\"\xb2\x02\n\x08\x44\x61tabase\x12\x19\n\x02id\x18\x01 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06seq_id\x18\x02 \x01(\x04\x12\x10\n\x08location\x18\x03 \x01(\t\x12\x37\n\nparameters\x18\x04 \x03(\x0b\x32#.metastore.Database.ParametersEntry\x12\x44\n\x11system_parameters\x18\x05 \x03(\x0b\x32).metastore.Database.SystemParametersEntry\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x37\n\x15SystemParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"_\n\x15\x43reateDatabaseRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12%\n\x08\x64\x61tabase\x18\x02 \x01(\x0b\x32\x13.metastore.Database\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\"P\n\x12GetDatabaseRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x19\n\x02id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\"f\n\x13GetDatabaseResponse\x12%\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x13.metastore.Database\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\"e\n\x14ListDatabasesRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x0e\n\x06\x63ookie\x18\x02 \x01(\t\x12\x14\n\x0cname_pattern\x18\x03 \x01(\t\x12\x16\n\x0e\x65xclude_params\x18\x04 \x01(\x08\"Q\n\x13\x44ropDatabaseRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x19\n\x02id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\":\n\x0b\x46ieldSchema\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0f\n\x07\x63omment\x18\x03 \x01(\t\"\xc4\x01\n\tSerDeInfo\x12\"\n\x04type\x18\x01 \x01(\x0e\x32\x14.metastore.SerdeType\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x18\n\x10serializationLib\x18\x03 \x01(\t\x12\x38\n\nparameters\x18\x04 \x03(\x0b\x32$.metastore.SerDeInfo.ParametersEntry\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\'\n\x05Order\x12\x0b\n\x03\x63ol\x18\x01 \x01(\t\x12\x11\n\tascending\x18\x02 \x01(\x08\"\xcc\x04\n\x11StorageDescriptor\x12$\n\x04\x63ols\x18\x01 \x03(\x0b\x32\x16.metastore.FieldSchema\x12\x10\n\x08location\x18\x02 \x01(\t\x12+\n\x0binputFormat\x18\x03 \x01(\x0e\x32\x16.metastore.InputFormat\x12\x17\n\x0finputFormatName\x18\x04 \x01(\t\x12-\n\x0coutputFormat\x18\x05 \x01(\x0e\x32\x17.metastore.OutputFormat\x12\x18\n\x10outputFormatName\x18\x06 \x01(\t\x12\x12\n\nnumBuckets\x18\x07 \x01(\x05\x12\'\n\tserdeInfo\x18\x08 \x01(\x0b\x32\x14.metastore.SerDeInfo\x12\x12\n\nbucketCols\x18\t \x03(\t\x12\"\n\x08sortCols\x18\n \x03(\x0b\x32\x10.metastore.Order\x12@\n\nparameters\x18\x0b \x03(\x0b\x32,.metastore.StorageDescriptor.ParametersEntry\x12M\n\x11system_parameters\x18\x0c \x03(\x0b\x32\x32.metastore.StorageDescriptor.SystemParametersEntry\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x37\n\x15SystemParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x99\x03\n\x05Table\x12\x19\n\x02id\x18\x01 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06seq_id\x18\x03 \x01(\x04\x12(\n\x02sd\x18\x04 \x01(\x0b\x32\x1c.metastore.StorageDescriptor\x12-\n\rpartitionKeys\x18\x05 \x03(\x0b\x32\x16.metastore.FieldSchema\x12\'\n\ttableType\x18\x06 \x01(\x0e\x32\x14.metastore.TableType\x12\x34\n\nparameters\x18\x07 \x03(\x0b\x32 .metastore.Table.ParametersEntry\x12\x41\n\x11system_parameters\x18\x08 \x03(\x0b\x32&.metastore.Table.SystemParametersEntry\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x37\n\x15SystemParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"t\n\x12\x43reateTableRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x05table\x18\x03 \x01(\x0b\x32\x10.metastore.Table\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\"k\n\x0fGetTableRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\"]\n\x10GetTableResponse\x12\x1f\n\x05table\x18\x01 \x01(\x0b\x32\x10.metastore.Table\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\"R\n\x11ListTablesRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\"l\n\x10\x44ropTableRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t*\xa8\x01\n\tSerdeType\x12\x10\n\x0cSERDE_CUSTOM\x10\x00\x12\x15\n\x11SERDE_LAZY_SIMPLE\x10\x01\x12\x0e\n\nSERDE_AVRO\x10\x02\x12\x0e\n\nSERDE_JSON\x10\x03\x12\r\n\tSERDE_ORC\x10\x04\x12\x0f\n\x0bSERDE_REGEX\x10\x05\x12\x10\n\x0cSERDE_THRIFT\x10\x06\x12\x11\n\rSERDE_PARQUET\x10\x07\x12\r\n\tSERDE_CSV\x10\x08*G\n\x0bInputFormat\x12\r\n\tIF_CUSTOM\x10\x00\x12\x0f\n\x0bIF_SEQUENCE\x10\x01\x12\x0b\n\x07IF_TEXT\x10\x02\x12\x0b\n\x07IF_HIVE\x10\x03*N\n\x0cOutputFormat\x12\r\n\tOF_CUSTOM\x10\x00\x12\x0f\n\x0bOF_SEQUENCE\x10\x02\x12\x11\n\rOF_IGNORE_KEY\x10\x03\x12\x0b\n\x07OF_HIVE\x10\x04*C\n\tTableType\x12\x11\n\rTTYPE_MANAGED\x10\x00\x12\x12\n\x0eTTYPE_EXTERNAL\x10\x01\x12\x0f\n\x0bTTYPE_INDEX\x10\x02\x32\xf0\x05\n\tMetastore\x12R\n\x0e\x43reateDabatase\x12 .metastore.CreateDatabaseRequest\x1a\x1e.metastore.GetDatabaseResponse\x12p\n\x0bGetDatabase\x12\x1d.metastore.GetDatabaseRequest\x1a\x1e.metastore.GetDatabaseResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v2/db/{catalog}/{id.name}\x12\x61\n\rListDatabases\x12\x1f.metastore.ListDatabasesRequest\x1a\x13.metastore.Database\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v2/db/{catalog}0\x01\x12H\n\x0c\x44ropDatabase\x12\x1e.metastore.DropDatabaseRequest\x1a\x18.metastore.RequestStatus\x12I\n\x0b\x43reateTable\x12\x1d.metastore.CreateTableRequest\x1a\x1b.metastore.GetTableResponse\x12w\n\x08GetTable\x12\x1a.metastore.GetTableRequest\x1a\x1b.metastore.GetTableResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v2/table/{catalog}/{db_id.name}/{id.name}\x12h\n\nListTables\x12\x1c.metastore.ListTablesRequest\x1a\x10.metastore.Table\"(\x82\xd3\xe4\x93\x02\"\x12 /v2/table/{catalog}/{db_id.name}0\x01\x12\x42\n\tDropTable\x12\x1b.metastore.DropTableRequest\x1a\x18.metastore.RequestStatusB\xd8\x01\n\x13\x63om.akolb.metastoreB\tMetaStoreP\x01\x92\x41\xb2\x01\x12j\n\x12Hive Metastore Api\"O\n\x16Hive Metastore Project\x12\"https://github.com/akolb1/hmsv2api\x1a\x11\x61kolb1@google.com2\x03\x31.0rD\n\x1eMetastore API V2 Documentation\x12\"https://github.com/akolb1/hmsv2apib\x06proto3')
+  serialized_pb=_b('\n\x0fmetastore.proto\x12\tmetastore\x1a\x1cgoogle/api/annotations.proto\x1a,protoc-gen-swagger/options/annotations.proto\"\xe3\x01\n\rRequestStatus\x12/\n\x06status\x18\x01 \x01(\x0e\x32\x1f.metastore.RequestStatus.Status\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x91\x01\n\x06Status\x12\r\n\tSTATUS_OK\x10\x00\x12\x10\n\x0cSTATUS_ERROR\x10\x01\x12\x13\n\x0fSTATUS_NOTFOUND\x10\x02\x12\x13\n\x0fSTATUS_CONFLICT\x10\x03\x12\x0f\n\x0bSTATUS_BUSY\x10\x04\x12\x17\n\x13STATUS_INTERNAL_ERR\x10\x05\x12\x12\n\x0eSTATUS_INVALID\x10\x06\"\x1e\n\x02Id\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\"\xa9\x01\n\x07\x43\x61talog\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x10\n\x08location\x18\x03 \x01(\t\x12\x36\n\nparameters\x18\x04 \x03(\x0b\x32\".metastore.Catalog.ParametersEntry\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"K\n\x14\x43reateCatalogRequest\x12#\n\x07\x63\x61talog\x18\x01 \x01(\x0b\x32\x12.metastore.Catalog\x12\x0e\n\x06\x63ookie\x18\x02 \x01(\t\"1\n\x11GetCatalogRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06\x63ookie\x18\x02 \x01(\t\"c\n\x12GetCatalogResponse\x12#\n\x07\x63\x61talog\x18\x01 \x01(\x0b\x32\x12.metastore.Catalog\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\"%\n\x13ListCatalogsRequest\x12\x0e\n\x06\x63ookie\x18\x01 \x01(\t\"X\n\x13\x41lterCatalogRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12#\n\x07\x63\x61talog\x18\x02 \x01(\x0b\x32\x12.metastore.Catalog\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\"2\n\x12\x44ropCatalogRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06\x63ookie\x18\x02 \x01(\t\"\xa6\x03\n\x08\x44\x61tabase\x12\x19\n\x02id\x18\x01 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06seq_id\x18\x02 \x01(\x04\x12\x10\n\x08location\x18\x03 \x01(\t\x12\x37\n\nparameters\x18\x04 \x03(\x0b\x32#.metastore.Database.ParametersEntry\x12\x44\n\x11system_parameters\x18\x05 \x03(\x0b\x32).metastore.Database.SystemParametersEntry\x12\x13\n\x0b\x63reate_time\x18\x06 \x01(\x03\x12\x1a\n\x12last_modified_time\x18\x07 \x01(\x03\x12\x18\n\x10last_access_time\x18\x08 \x01(\x03\x12\x12\n\ncreated_by\x18\t \x01(\t\x12\x13\n\x0bmodified_by\x18\n \x01(\t\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x37\n\x15SystemParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"_\n\x15\x43reateDatabaseRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12%\n\x08\x64\x61tabase\x18\x02 \x01(\x0b\x32\x13.metastore.Database\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\"y\n\x14\x41lterDatabaseRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x19\n\x02id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12%\n\x08\x64\x61tabase\x18\x03 \x01(\x0b\x32\x13.metastore.Database\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\"P\n\x12GetDatabaseRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x19\n\x02id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\"f\n\x13GetDatabaseResponse\x12%\n\x08\x64\x61tabase\x18\x01 \x01(\x0b\x32\x13.metastore.Database\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\"\x8d\x01\n\x14ListDatabasesRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x0e\n\x06\x63ookie\x18\x02 \x01(\t\x12\x14\n\x0cname_pattern\x18\x03 \x01(\t\x12\x16\n\x0e\x65xclude_params\x18\x04 \x01(\x08\x12\x0e\n\x06\x66ields\x18\x05 \x03(\t\x12\x16\n\x0emodified_since\x18\x06 \x01(\x03\"`\n\x13\x44ropDatabaseRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x19\n\x02id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\x12\r\n\x05purge\x18\x04 \x01(\x08\":\n\x0b\x46ieldSchema\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0f\n\x07\x63omment\x18\x03 \x01(\t\"\xc4\x01\n\tSerDeInfo\x12\"\n\x04type\x18\x01 \x01(\x0e\x32\x14.metastore.SerdeType\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x18\n\x10serializationLib\x18\x03 \x01(\t\x12\x38\n\nparameters\x18\x04 \x03(\x0b\x32$.metastore.SerDeInfo.ParametersEntry\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\'\n\x05Order\x12\x0b\n\x03\x63ol\x18\x01 \x01(\t\x12\x11\n\tascending\x18\x02 \x01(\x08\"\xba\x04\n\x11StorageDescriptor\x12$\n\x04\x63ols\x18\x01 \x03(\x0b\x32\x16.metastore.FieldSchema\x12+\n\x0binputFormat\x18\x03 \x01(\x0e\x32\x16.metastore.InputFormat\x12\x17\n\x0finputFormatName\x18\x04 \x01(\t\x12-\n\x0coutputFormat\x18\x05 \x01(\x0e\x32\x17.metastore.OutputFormat\x12\x18\n\x10outputFormatName\x18\x06 \x01(\t\x12\x12\n\nnumBuckets\x18\x07 \x01(\x05\x12\'\n\tserdeInfo\x18\x08 \x01(\x0b\x32\x14.metastore.SerDeInfo\x12\x12\n\nbucketCols\x18\t \x03(\t\x12\"\n\x08sortCols\x18\n \x03(\x0b\x32\x10.metastore.Order\x12@\n\nparameters\x18\x0b \x03(\x0b\x32,.metastore.StorageDescriptor.ParametersEntry\x12M\n\x11system_parameters\x18\x0c \x03(\x0b\x32\x32.metastore.StorageDescriptor.SystemParametersEntry\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x37\n\x15SystemParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xb7\x04\n\x05Table\x12\x19\n\x02id\x18\x01 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06seq_id\x18\x03 \x01(\x04\x12(\n\x02sd\x18\x04 \x01(\x0b\x32\x1c.metastore.StorageDescriptor\x12-\n\rpartitionKeys\x18\x05 \x03(\x0b\x32\x16.metastore.FieldSchema\x12\'\n\ttableType\x18\x06 \x01(\x0e\x32\x14.metastore.TableType\x12\x34\n\nparameters\x18\x07 \x03(\x0b\x32 .metastore.Table.ParametersEntry\x12\x41\n\x11system_parameters\x18\x08 \x03(\x0b\x32&.metastore.Table.SystemParametersEntry\x12\x10\n\x08location\x18\t \x01(\t\x12\x13\n\x0b\x63reate_time\x18\n \x01(\x03\x12\x1a\n\x12last_modified_time\x18\x0b \x01(\x03\x12\x18\n\x10last_access_time\x18\x0c \x01(\x03\x12\x12\n\ncreated_by\x18\r \x01(\t\x12\x13\n\x0bmodified_by\x18\x0e \x01(\t\x12\x16\n\x0eschema_version\x18\x0f \x01(\x05\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x37\n\x15SystemParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"m\n\x0bTableSchema\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12$\n\x04\x63ols\x18\x02 \x03(\x0b\x32\x16.metastore.FieldSchema\x12\x13\n\x0b\x63reate_time\x18\x03 \x01(\x03\x12\x12\n\ncreated_by\x18\x04 \x01(\t\"t\n\x12\x43reateTableRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x05table\x18\x03 \x01(\x0b\x32\x10.metastore.Table\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\"k\n\x0fGetTableRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\"]\n\x10GetTableResponse\x12\x1f\n\x05table\x18\x01 \x01(\x0b\x32\x10.metastore.Table\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\"z\n\x11ListTablesRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\x12\x0e\n\x06\x66ields\x18\x04 \x03(\t\x12\x16\n\x0emodified_since\x18\x05 \x01(\x03\"{\n\x10\x44ropTableRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\x12\r\n\x05purge\x18\x05 \x01(\x08\"\xe2\x01\n\rDroppedObject\x12+\n\x04kind\x18\x01 \x01(\x0e\x32\x1d.metastore.DroppedObject.Kind\x12\x19\n\x02id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x1c\n\x05\x64\x62_id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x11\n\tdrop_time\x18\x04 \x01(\x03\x12\x13\n\x0b\x65xpire_time\x18\x05 \x01(\x03\x12\x12\n\ndropped_by\x18\x06 \x01(\t\"/\n\x04Kind\x12\x11\n\rDROPPED_TABLE\x10\x00\x12\x14\n\x10\x44ROPPED_DATABASE\x10\x01\"S\n\x12ListDroppedRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\"N\n\rUndropRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x10\n\x08new_name\x18\x03 \x01(\t\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\"\xa3\x01\n\x11\x41lterTableRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x05table\x18\x04 \x01(\x0b\x32\x10.metastore.Table\x12\x0e\n\x06\x63ookie\x18\x05 \x01(\t\x12\x13\n\x0bupdate_mask\x18\x06 \x03(\t\"\xe2\x01\n\x15SchemaIncompatibility\x12\x33\n\x04kind\x18\x01 \x01(\x0e\x32%.metastore.SchemaIncompatibility.Kind\x12\x0e\n\x06\x63olumn\x18\x02 \x01(\t\x12\x10\n\x08old_type\x18\x03 \x01(\t\x12\x10\n\x08new_type\x18\x04 \x01(\t\x12\x0f\n\x07message\x18\x05 \x01(\t\"O\n\x04Kind\x12\x18\n\x14INCOMPATIBLE_DROPPED\x10\x00\x12\x16\n\x12INCOMPATIBLE_MOVED\x10\x01\x12\x15\n\x11INCOMPATIBLE_TYPE\x10\x02\"\xa4\x01\n\x11\x41\x64\x64\x43olumnsRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12$\n\x04\x63ols\x18\x04 \x03(\x0b\x32\x16.metastore.FieldSchema\x12\x0f\n\x07\x63\x61scade\x18\x05 \x01(\x08\x12\x0e\n\x06\x63ookie\x18\x06 \x01(\t\"\xa8\x01\n\x15ReplaceColumnsRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12$\n\x04\x63ols\x18\x04 \x03(\x0b\x32\x16.metastore.FieldSchema\x12\x0f\n\x07\x63\x61scade\x18\x05 \x01(\x08\x12\x0e\n\x06\x63ookie\x18\x06 \x01(\t\"\xd4\x01\n\x13\x43hangeColumnRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0c\n\x04name\x18\x04 \x01(\t\x12&\n\x06\x63olumn\x18\x05 \x01(\x0b\x32\x16.metastore.FieldSchema\x12\r\n\x05\x66irst\x18\x06 \x01(\x08\x12\r\n\x05\x61\x66ter\x18\x07 \x01(\t\x12\x0f\n\x07\x63\x61scade\x18\x08 \x01(\x08\x12\x0e\n\x06\x63ookie\x18\t \x01(\t\"\x8c\x01\n\x11\x44ropColumnRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x19\n\x02id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x0f\n\x07\x63\x61scade\x18\x05 \x01(\x08\x12\x0e\n\x06\x63ookie\x18\x06 \x01(\t\"\x9c\x01\n\x12\x41lterTableResponse\x12\x1f\n\x05table\x18\x01 \x01(\x0b\x32\x10.metastore.Table\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\x12;\n\x11incompatibilities\x18\x03 \x03(\x0b\x32 .metastore.SchemaIncompatibility\"\xb9\x03\n\tPartition\x12\x19\n\x02id\x18\x01 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06seq_id\x18\x02 \x01(\x04\x12\x0e\n\x06values\x18\x03 \x03(\t\x12(\n\x02sd\x18\x04 \x01(\x0b\x32\x1c.metastore.StorageDescriptor\x12\x38\n\nparameters\x18\x05 \x03(\x0b\x32$.metastore.Partition.ParametersEntry\x12\x10\n\x08location\x18\x06 \x01(\t\x12\x1f\n\x05table\x18\x07 \x01(\x0b\x32\x10.metastore.Table\x12\x13\n\x0b\x63reate_time\x18\x08 \x01(\x03\x12\x1a\n\x12last_modified_time\x18\t \x01(\x03\x12\x18\n\x10last_access_time\x18\n \x01(\x03\x12\x12\n\ncreated_by\x18\x0b \x01(\t\x12\x13\n\x0bmodified_by\x18\x0c \x01(\t\x12\x1b\n\x13inherited_sd_fields\x18\r \x03(\t\x12\x16\n\x0eschema_version\x18\x0e \x01(\x05\x1a\x31\n\x0fParametersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xa0\x01\n\x13\x41\x64\x64PartitionRequest\x12\x10\n\x08sequence\x18\x01 \x01(\x04\x12\x0f\n\x07\x63\x61talog\x18\x02 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x08table_id\x18\x04 \x01(\x0b\x32\r.metastore.Id\x12\'\n\tpartition\x18\x05 \x01(\x0b\x32\x14.metastore.Partition\"R\n\x14\x41\x64\x64PartitionResponse\x12\x10\n\x08sequence\x18\x01 \x01(\x04\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\"\x9d\x01\n\x13GetPartitionRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x08table_id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06values\x18\x04 \x03(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x18\n\x10\x65\x66\x66\x65\x63tive_schema\x18\x06 \x01(\x08\"\x91\x01\n\x14GetPartitionResponse\x12\'\n\tpartition\x18\x01 \x01(\x0b\x32\x14.metastore.Partition\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\x12&\n\x06schema\x18\x03 \x01(\x0b\x32\x16.metastore.TableSchema\"\xba\x02\n\x15ListPartitionsRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x08table_id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\x12\x0e\n\x06\x66ields\x18\x05 \x03(\t\x12*\n\x06values\x18\x06 \x03(\x0b\x32\x1a.metastore.PartitionValues\x12\x0f\n\x07\x65xclude\x18\x07 \x03(\t\x12\x16\n\x0emodified_since\x18\x08 \x01(\x03\x12\r\n\x05names\x18\t \x03(\t\x12\x0c\n\x04\x66rom\x18\n \x03(\t\x12\n\n\x02to\x18\x0b \x03(\t\x12\x0e\n\x06prefix\x18\x0c \x03(\t\x12\x12\n\ndescending\x18\r \x01(\x08\x12\x0f\n\x07\x63ompact\x18\x0e \x01(\x08\" \n\x0fPartitionValues\x12\r\n\x05value\x18\x01 \x03(\t\"\xc2\x01\n\x15\x44ropPartitionsRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x08table_id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12*\n\x06values\x18\x04 \x03(\x0b\x32\x1a.metastore.PartitionValues\x12\x0e\n\x06\x63ookie\x18\x05 \x01(\t\x12\r\n\x05names\x18\x06 \x03(\t\x12\x0e\n\x06prefix\x18\x07 \x03(\t\"\x8d\x01\n\x18GetPartitionNamesRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x08table_id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06\x63ookie\x18\x04 \x01(\t\x12\x11\n\tmax_parts\x18\x05 \x01(\x05\"T\n\x19GetPartitionNamesResponse\x12\r\n\x05names\x18\x01 \x03(\t\x12(\n\x06status\x18\x02 \x01(\x0b\x32\x18.metastore.RequestStatus\"?\n\x10ResolveIdRequest\x12\x0f\n\x07\x63\x61talog\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0e\n\x06\x63ookie\x18\x03 \x01(\t\"\xff\x02\n\x11ResolveIdResponse\x12/\n\x04kind\x18\x01 \x01(\x0e\x32!.metastore.ResolveIdResponse.Kind\x12\x1c\n\x05\x64\x62_id\x18\x02 \x01(\x0b\x32\r.metastore.Id\x12\x1f\n\x08table_id\x18\x03 \x01(\x0b\x32\r.metastore.Id\x12\x0e\n\x06values\x18\x04 \x03(\t\x12%\n\x08\x64\x61tabase\x18\x05 \x01(\x0b\x32\x13.metastore.Database\x12\x1f\n\x05table\x18\x06 \x01(\x0b\x32\x10.metastore.Table\x12\'\n\tpartition\x18\x07 \x01(\x0b\x32\x14.metastore.Partition\x12(\n\x06status\x18\x08 \x01(\x0b\x32\x18.metastore.RequestStatus\"O\n\x04Kind\x12\x10\n\x0cKIND_UNKNOWN\x10\x00\x12\x11\n\rKIND_DATABASE\x10\x01\x12\x0e\n\nKIND_TABLE\x10\x02\x12\x12\n\x0eKIND_PARTITION\x10\x03\"3\n\rBackupRequest\x12\x12\n\nchunk_size\x18\x01 \x01(\r\x12\x0e\n\x06\x63ookie\x18\x02 \x01(\t\"8\n\x0b\x42\x61\x63kupChunk\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\x12\x0c\n\x04size\x18\x02 \x01(\x03\x12\r\n\x05tx_id\x18\x03 \x01(\x04*\xa8\x01\n\tSerdeType\x12\x10\n\x0cSERDE_CUSTOM\x10\x00\x12\x15\n\x11SERDE_LAZY_SIMPLE\x10\x01\x12\x0e\n\nSERDE_AVRO\x10\x02\x12\x0e\n\nSERDE_JSON\x10\x03\x12\r\n\tSERDE_ORC\x10\x04\x12\x0f\n\x0bSERDE_REGEX\x10\x05\x12\x10\n\x0cSERDE_THRIFT\x10\x06\x12\x11\n\rSERDE_PARQUET\x10\x07\x12\r\n\tSERDE_CSV\x10\x08*W\n\x0bInputFormat\x12\r\n\tIF_CUSTOM\x10\x00\x12\x0f\n\x0bIF_SEQUENCE\x10\x01\x12\x0b\n\x07IF_TEXT\x10\x02\x12\x0b\n\x07IF_HIVE\x10\x03\x12\x0e\n\nIF_PARQUET\x10\x04*^\n\x0cOutputFormat\x12\r\n\tOF_CUSTOM\x10\x00\x12\x0f\n\x0bOF_SEQUENCE\x10\x02\x12\x11\n\rOF_IGNORE_KEY\x10\x03\x12\x0b\n\x07OF_HIVE\x10\x04\x12\x0e\n\nOF_PARQUET\x10\x05*C\n\tTableType\x12\x11\n\rTTYPE_MANAGED\x10\x00\x12\x12\n\x0eTTYPE_EXTERNAL\x10\x01\x12\x0f\n\x0bTTYPE_INDEX\x10\x02*E\n\x10SerializationLib\x12\r\n\tSL_CUSTOM\x10\x00\x12\x12\n\x0eSL_LAZY_SIMPLE\x10\x01\x12\x0e\n\nSL_PARQUET\x10\x02\x32\xd0\x14\n\tMetastore\x12O\n\rCreateCatalog\x12\x1f.metastore.CreateCatalogRequest\x1a\x1d.metastore.GetCatalogResponse\x12\x65\n\nGetCatalog\x12\x1c.metastore.GetCatalogRequest\x1a\x1d.metastore.GetCatalogResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v2/catalog/{name}\x12Y\n\x0cListCatalogs\x12\x1e.metastore.ListCatalogsRequest\x1a\x12.metastore.Catalog\"\x13\x82\xd3\xe4\x93\x02\r\x12\x0b/v2/catalog0\x01\x12M\n\x0c\x41lterCatalog\x12\x1e.metastore.AlterCatalogRequest\x1a\x1d.metastore.GetCatalogResponse\x12\x46\n\x0b\x44ropCatalog\x12\x1d.metastore.DropCatalogRequest\x1a\x18.metastore.RequestStatus\x12R\n\x0e\x43reateDabatase\x12 .metastore.CreateDatabaseRequest\x1a\x1e.metastore.GetDatabaseResponse\x12p\n\x0bGetDatabase\x12\x1d.metastore.GetDatabaseRequest\x1a\x1e.metastore.GetDatabaseResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v2/db/{catalog}/{id.name}\x12\x61\n\rListDatabases\x12\x1f.metastore.ListDatabasesRequest\x1a\x13.metastore.Database\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v2/db/{catalog}0\x01\x12H\n\x0c\x44ropDatabase\x12\x1e.metastore.DropDatabaseRequest\x1a\x18.metastore.RequestStatus\x12P\n\rAlterDatabase\x12\x1f.metastore.AlterDatabaseRequest\x1a\x1e.metastore.GetDatabaseResponse\x12I\n\x0b\x43reateTable\x12\x1d.metastore.CreateTableRequest\x1a\x1b.metastore.GetTableResponse\x12w\n\x08GetTable\x12\x1a.metastore.GetTableRequest\x1a\x1b.metastore.GetTableResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v2/table/{catalog}/{db_id.name}/{id.name}\x12h\n\nListTables\x12\x1c.metastore.ListTablesRequest\x1a\x10.metastore.Table\"(\x82\xd3\xe4\x93\x02\"\x12 /v2/table/{catalog}/{db_id.name}0\x01\x12\x42\n\tDropTable\x12\x1b.metastore.DropTableRequest\x1a\x18.metastore.RequestStatus\x12I\n\nAlterTable\x12\x1c.metastore.AlterTableRequest\x1a\x1d.metastore.AlterTableResponse\x12I\n\nAddColumns\x12\x1c.metastore.AddColumnsRequest\x1a\x1d.metastore.AlterTableResponse\x12Q\n\x0eReplaceColumns\x12 .metastore.ReplaceColumnsRequest\x1a\x1d.metastore.AlterTableResponse\x12M\n\x0c\x43hangeColumn\x12\x1e.metastore.ChangeColumnRequest\x1a\x1d.metastore.AlterTableResponse\x12I\n\nDropColumn\x12\x1c.metastore.DropColumnRequest\x1a\x1d.metastore.AlterTableResponse\x12H\n\x0bListDropped\x12\x1d.metastore.ListDroppedRequest\x1a\x18.metastore.DroppedObject0\x01\x12<\n\x06Undrop\x12\x18.metastore.UndropRequest\x1a\x18.metastore.RequestStatus\x12O\n\x0c\x41\x64\x64Partition\x12\x1e.metastore.AddPartitionRequest\x1a\x1f.metastore.AddPartitionResponse\x12X\n\x11\x41\x64\x64ManyPartitions\x12\x1e.metastore.AddPartitionRequest\x1a\x1f.metastore.AddPartitionResponse(\x01\x30\x01\x12\x8d\x01\n\x0cGetPartition\x12\x1e.metastore.GetPartitionRequest\x1a\x1f.metastore.GetPartitionResponse\"<\x82\xd3\xe4\x93\x02\x36\x12\x34/v2/partition/{catalog}/{db_id.name}/{table_id.name}\x12\x89\x01\n\x0eListPartitions\x12 .metastore.ListPartitionsRequest\x1a\x14.metastore.Partition\"=\x82\xd3\xe4\x93\x02\x37\x12\x35/v2/partitions/{catalog}/{db_id.name}/{table_id.name}0\x01\x12\x9c\x01\n\x11GetPartitionNames\x12#.metastore.GetPartitionNamesRequest\x1a$.metastore.GetPartitionNamesResponse\"<\x82\xd3\xe4\x93\x02\x36\x12\x34/v2/partnames/{catalog}/{db_id.name}/{table_id.name}\x12L\n\x0e\x44ropPartitions\x12 .metastore.DropPartitionsRequest\x1a\x18.metastore.RequestStatus\x12\x65\n\tResolveId\x12\x1b.metastore.ResolveIdRequest\x1a\x1c.metastore.ResolveIdResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v2/id/{catalog}/{id}\x12<\n\x06\x42\x61\x63kup\x12\x18.metastore.BackupRequest\x1a\x16.metastore.BackupChunk0\x01\x42\xd8\x01\x92\x41\xb2\x01\x12j\n\x12Hive Metastore Api\"O\n\x16Hive Metastore Project\x12\"https://github.com/akolb1/hmsv2api\x1a\x11\x61kolb1@google.com2\x03\x31.0rD\n\x1eMetastore API V2 Documentation\x12\"https://github.com/akolb1/hmsv2api\n\x13\x63om.akolb.metastoreB\tMetaStoreP\x01\x62\x06proto3')
   ,
   dependencies=[google_dot_api_dot_annotations__pb2.DESCRIPTOR,protoc__gen__swagger_dot_options_dot_annotations__pb2.DESCRIPTOR,])
 
@@ -71,8 +71,8 @@ _SERDETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=2945,
-  serialized_end=3113,
+  serialized_start=8132,
+  serialized_end=8300,
 )
 _sym_db.RegisterEnumDescriptor(_SERDETYPE)
 
@@ -99,11 +99,15 @@ _INPUTFORMAT = _descriptor.EnumDescriptor(
       name='IF_HIVE', index=3, number=3,
       options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='IF_PARQUET', index=4, number=4,
+      options=None,
+      type=None),
   ],
   containing_type=None,
   options=None,
-  serialized_start=3115,
-  serialized_end=3186,
+  serialized_start=8302,
+  serialized_end=8389,
 )
 _sym_db.RegisterEnumDescriptor(_INPUTFORMAT)
 
@@ -130,11 +134,15 @@ _OUTPUTFORMAT = _descriptor.EnumDescriptor(
       name='OF_HIVE', index=3, number=4,
       options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='OF_PARQUET', index=4, number=5,
+      options=None,
+      type=None),
   ],
   containing_type=None,
   options=None,
-  serialized_start=3188,
-  serialized_end=3266,
+  serialized_start=8391,
+  serialized_end=8485,
 )
 _sym_db.RegisterEnumDescriptor(_OUTPUTFORMAT)
 
@@ -160,12 +168,39 @@ _TABLETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=3268,
-  serialized_end=3335,
+  serialized_start=8487,
+  serialized_end=8554,
 )
 _sym_db.RegisterEnumDescriptor(_TABLETYPE)
 
 TableType = enum_type_wrapper.EnumTypeWrapper(_TABLETYPE)
+_SERIALIZATIONLIB = _descriptor.EnumDescriptor(
+  name='SerializationLib',
+  full_name='metastore.SerializationLib',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='SL_CUSTOM', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='SL_LAZY_SIMPLE', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='SL_PARQUET', index=2, number=2,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=8556,
+  serialized_end=8625,
+)
+_sym_db.RegisterEnumDescriptor(_SERIALIZATIONLIB)
+
+SerializationLib = enum_type_wrapper.EnumTypeWrapper(_SERIALIZATIONLIB)
 SERDE_CUSTOM = 0
 SERDE_LAZY_SIMPLE = 1
 SERDE_AVRO = 2
@@ -179,13 +214,18 @@ IF_CUSTOM = 0
 IF_SEQUENCE = 1
 IF_TEXT = 2
 IF_HIVE = 3
+IF_PARQUET = 4
 OF_CUSTOM = 0
 OF_SEQUENCE = 2
 OF_IGNORE_KEY = 3
 OF_HIVE = 4
+OF_PARQUET = 5
 TTYPE_MANAGED = 0
 TTYPE_EXTERNAL = 1
 TTYPE_INDEX = 2
+SL_CUSTOM = 0
+SL_LAZY_SIMPLE = 1
+SL_PARQUET = 2
 
 
 _REQUESTSTATUS_STATUS = _descriptor.EnumDescriptor(
@@ -218,15 +258,100 @@ _REQUESTSTATUS_STATUS = _descriptor.EnumDescriptor(
       name='STATUS_INTERNAL_ERR', index=5, number=5,
       options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='STATUS_INVALID', index=6, number=6,
+      options=None,
+      type=None),
   ],
   containing_type=None,
   options=None,
-  serialized_start=188,
-  serialized_end=313,
+  serialized_start=189,
+  serialized_end=334,
 )
 _sym_db.RegisterEnumDescriptor(_REQUESTSTATUS_STATUS)
 
 
+_DROPPEDOBJECT_KIND = _descriptor.EnumDescriptor(
+  name='Kind',
+  full_name='metastore.DroppedObject.Kind',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='DROPPED_TABLE', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='DROPPED_DATABASE', index=1, number=1,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=4328,
+  serialized_end=4375,
+)
+_sym_db.RegisterEnumDescriptor(_DROPPEDOBJECT_KIND)
+
+
+_SCHEMAINCOMPATIBILITY_KIND = _descriptor.EnumDescriptor(
+  name='Kind',
+  full_name='metastore.SchemaIncompatibility.Kind',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='INCOMPATIBLE_DROPPED', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='INCOMPATIBLE_MOVED', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='INCOMPATIBLE_TYPE', index=2, number=2,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=4856,
+  serialized_end=4935,
+)
+_sym_db.RegisterEnumDescriptor(_SCHEMAINCOMPATIBILITY_KIND)
+
+
+_RESOLVEIDRESPONSE_KIND = _descriptor.EnumDescriptor(
+  name='Kind',
+  full_name='metastore.ResolveIdResponse.Kind',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='KIND_UNKNOWN', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='KIND_DATABASE', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='KIND_TABLE', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='KIND_PARTITION', index=3, number=3,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=7939,
+  serialized_end=8018,
+)
+_sym_db.RegisterEnumDescriptor(_RESOLVEIDRESPONSE_KIND)
+
+
 _REQUESTSTATUS = _descriptor.Descriptor(
   name='RequestStatus',
   full_name='metastore.RequestStatus',
@@ -262,7 +387,7 @@ _REQUESTSTATUS = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=107,
-  serialized_end=313,
+  serialized_end=334,
 )
 
 
@@ -299,27 +424,27 @@ _ID = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=315,
-  serialized_end=345,
+  serialized_start=336,
+  serialized_end=366,
 )
 
 
-_DATABASE_PARAMETERSENTRY = _descriptor.Descriptor(
+_CATALOG_PARAMETERSENTRY = _descriptor.Descriptor(
   name='ParametersEntry',
-  full_name='metastore.Database.ParametersEntry',
+  full_name='metastore.Catalog.ParametersEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='metastore.Database.ParametersEntry.key', index=0,
+      name='key', full_name='metastore.Catalog.ParametersEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='metastore.Database.ParametersEntry.value', index=1,
+      name='value', full_name='metastore.Catalog.ParametersEntry.value', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -337,93 +462,49 @@ _DATABASE_PARAMETERSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=548,
-  serialized_end=597,
+  serialized_start=489,
+  serialized_end=538,
 )
 
-_DATABASE_SYSTEMPARAMETERSENTRY = _descriptor.Descriptor(
-  name='SystemParametersEntry',
-  full_name='metastore.Database.SystemParametersEntry',
+_CATALOG = _descriptor.Descriptor(
+  name='Catalog',
+  full_name='metastore.Catalog',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='metastore.Database.SystemParametersEntry.key', index=0,
+      name='name', full_name='metastore.Catalog.name', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='metastore.Database.SystemParametersEntry.value', index=1,
+      name='description', full_name='metastore.Catalog.description', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
-  ],
-  extensions=[
-  ],
-  nested_types=[],
-  enum_types=[
-  ],
-  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=599,
-  serialized_end=654,
-)
-
-_DATABASE = _descriptor.Descriptor(
-  name='Database',
-  full_name='metastore.Database',
-  filename=None,
-  file=DESCRIPTOR,
-  containing_type=None,
-  fields=[
-    _descriptor.FieldDescriptor(
-      name='id', full_name='metastore.Database.id', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='seq_id', full_name='metastore.Database.seq_id', index=1,
-      number=2, type=4, cpp_type=4, label=1,
-      has_default_value=False, default_value=0,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='location', full_name='metastore.Database.location', index=2,
+      name='location', full_name='metastore.Catalog.location', index=2,
       number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='parameters', full_name='metastore.Database.parameters', index=3,
+      name='parameters', full_name='metastore.Catalog.parameters', index=3,
       number=4, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='system_parameters', full_name='metastore.Database.system_parameters', index=4,
-      number=5, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_DATABASE_PARAMETERSENTRY, _DATABASE_SYSTEMPARAMETERSENTRY, ],
+  nested_types=[_CATALOG_PARAMETERSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -432,35 +513,28 @@ _DATABASE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=348,
-  serialized_end=654,
+  serialized_start=369,
+  serialized_end=538,
 )
 
 
-_CREATEDATABASEREQUEST = _descriptor.Descriptor(
-  name='CreateDatabaseRequest',
-  full_name='metastore.CreateDatabaseRequest',
+_CREATECATALOGREQUEST = _descriptor.Descriptor(
+  name='CreateCatalogRequest',
+  full_name='metastore.CreateCatalogRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='catalog', full_name='metastore.CreateDatabaseRequest.catalog', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='database', full_name='metastore.CreateDatabaseRequest.database', index=1,
-      number=2, type=11, cpp_type=10, label=1,
+      name='catalog', full_name='metastore.CreateCatalogRequest.catalog', index=0,
+      number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='cookie', full_name='metastore.CreateDatabaseRequest.cookie', index=2,
-      number=3, type=9, cpp_type=9, label=1,
+      name='cookie', full_name='metastore.CreateCatalogRequest.cookie', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -477,35 +551,28 @@ _CREATEDATABASEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=656,
-  serialized_end=751,
+  serialized_start=540,
+  serialized_end=615,
 )
 
 
-_GETDATABASEREQUEST = _descriptor.Descriptor(
-  name='GetDatabaseRequest',
-  full_name='metastore.GetDatabaseRequest',
+_GETCATALOGREQUEST = _descriptor.Descriptor(
+  name='GetCatalogRequest',
+  full_name='metastore.GetCatalogRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='catalog', full_name='metastore.GetDatabaseRequest.catalog', index=0,
+      name='name', full_name='metastore.GetCatalogRequest.name', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='id', full_name='metastore.GetDatabaseRequest.id', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='cookie', full_name='metastore.GetDatabaseRequest.cookie', index=2,
-      number=3, type=9, cpp_type=9, label=1,
+      name='cookie', full_name='metastore.GetCatalogRequest.cookie', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -522,27 +589,27 @@ _GETDATABASEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=753,
-  serialized_end=833,
+  serialized_start=617,
+  serialized_end=666,
 )
 
 
-_GETDATABASERESPONSE = _descriptor.Descriptor(
-  name='GetDatabaseResponse',
-  full_name='metastore.GetDatabaseResponse',
+_GETCATALOGRESPONSE = _descriptor.Descriptor(
+  name='GetCatalogResponse',
+  full_name='metastore.GetCatalogResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='database', full_name='metastore.GetDatabaseResponse.database', index=0,
+      name='catalog', full_name='metastore.GetCatalogResponse.catalog', index=0,
       number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='status', full_name='metastore.GetDatabaseResponse.status', index=1,
+      name='status', full_name='metastore.GetCatalogResponse.status', index=1,
       number=2, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
@@ -560,46 +627,25 @@ _GETDATABASERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=835,
-  serialized_end=937,
+  serialized_start=668,
+  serialized_end=767,
 )
 
 
-_LISTDATABASESREQUEST = _descriptor.Descriptor(
-  name='ListDatabasesRequest',
-  full_name='metastore.ListDatabasesRequest',
+_LISTCATALOGSREQUEST = _descriptor.Descriptor(
+  name='ListCatalogsRequest',
+  full_name='metastore.ListCatalogsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='catalog', full_name='metastore.ListDatabasesRequest.catalog', index=0,
+      name='cookie', full_name='metastore.ListCatalogsRequest.cookie', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='cookie', full_name='metastore.ListDatabasesRequest.cookie', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='name_pattern', full_name='metastore.ListDatabasesRequest.name_pattern', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='exclude_params', full_name='metastore.ListDatabasesRequest.exclude_params', index=3,
-      number=4, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -612,34 +658,34 @@ _LISTDATABASESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=939,
-  serialized_end=1040,
+  serialized_start=769,
+  serialized_end=806,
 )
 
 
-_DROPDATABASEREQUEST = _descriptor.Descriptor(
-  name='DropDatabaseRequest',
-  full_name='metastore.DropDatabaseRequest',
+_ALTERCATALOGREQUEST = _descriptor.Descriptor(
+  name='AlterCatalogRequest',
+  full_name='metastore.AlterCatalogRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='catalog', full_name='metastore.DropDatabaseRequest.catalog', index=0,
+      name='name', full_name='metastore.AlterCatalogRequest.name', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='id', full_name='metastore.DropDatabaseRequest.id', index=1,
+      name='catalog', full_name='metastore.AlterCatalogRequest.catalog', index=1,
       number=2, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='cookie', full_name='metastore.DropDatabaseRequest.cookie', index=2,
+      name='cookie', full_name='metastore.AlterCatalogRequest.cookie', index=2,
       number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -657,39 +703,32 @@ _DROPDATABASEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1042,
-  serialized_end=1123,
+  serialized_start=808,
+  serialized_end=896,
 )
 
 
-_FIELDSCHEMA = _descriptor.Descriptor(
-  name='FieldSchema',
-  full_name='metastore.FieldSchema',
+_DROPCATALOGREQUEST = _descriptor.Descriptor(
+  name='DropCatalogRequest',
+  full_name='metastore.DropCatalogRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='name', full_name='metastore.FieldSchema.name', index=0,
+      name='name', full_name='metastore.DropCatalogRequest.name', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='type', full_name='metastore.FieldSchema.type', index=1,
+      name='cookie', full_name='metastore.DropCatalogRequest.cookie', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='comment', full_name='metastore.FieldSchema.comment', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -702,27 +741,27 @@ _FIELDSCHEMA = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1125,
-  serialized_end=1183,
+  serialized_start=898,
+  serialized_end=948,
 )
 
 
-_SERDEINFO_PARAMETERSENTRY = _descriptor.Descriptor(
+_DATABASE_PARAMETERSENTRY = _descriptor.Descriptor(
   name='ParametersEntry',
-  full_name='metastore.SerDeInfo.ParametersEntry',
+  full_name='metastore.Database.ParametersEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='metastore.SerDeInfo.ParametersEntry.key', index=0,
+      name='key', full_name='metastore.Database.ParametersEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='metastore.SerDeInfo.ParametersEntry.value', index=1,
+      name='value', full_name='metastore.Database.ParametersEntry.value', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -740,80 +779,2150 @@ _SERDEINFO_PARAMETERSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=548,
-  serialized_end=597,
+  serialized_start=489,
+  serialized_end=538,
 )
 
-_SERDEINFO = _descriptor.Descriptor(
-  name='SerDeInfo',
-  full_name='metastore.SerDeInfo',
+_DATABASE_SYSTEMPARAMETERSENTRY = _descriptor.Descriptor(
+  name='SystemParametersEntry',
+  full_name='metastore.Database.SystemParametersEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='type', full_name='metastore.SerDeInfo.type', index=0,
-      number=1, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='name', full_name='metastore.SerDeInfo.name', index=1,
-      number=2, type=9, cpp_type=9, label=1,
+      name='key', full_name='metastore.Database.SystemParametersEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='serializationLib', full_name='metastore.SerDeInfo.serializationLib', index=2,
-      number=3, type=9, cpp_type=9, label=1,
+      name='value', full_name='metastore.Database.SystemParametersEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='parameters', full_name='metastore.SerDeInfo.parameters', index=3,
-      number=4, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_SERDEINFO_PARAMETERSENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1186,
-  serialized_end=1382,
+  serialized_start=1318,
+  serialized_end=1373,
 )
 
-
-_ORDER = _descriptor.Descriptor(
-  name='Order',
-  full_name='metastore.Order',
+_DATABASE = _descriptor.Descriptor(
+  name='Database',
+  full_name='metastore.Database',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.Database.id', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='seq_id', full_name='metastore.Database.seq_id', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='location', full_name='metastore.Database.location', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='parameters', full_name='metastore.Database.parameters', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='system_parameters', full_name='metastore.Database.system_parameters', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='create_time', full_name='metastore.Database.create_time', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last_modified_time', full_name='metastore.Database.last_modified_time', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last_access_time', full_name='metastore.Database.last_access_time', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='created_by', full_name='metastore.Database.created_by', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='modified_by', full_name='metastore.Database.modified_by', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_DATABASE_PARAMETERSENTRY, _DATABASE_SYSTEMPARAMETERSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=951,
+  serialized_end=1373,
+)
+
+
+_CREATEDATABASEREQUEST = _descriptor.Descriptor(
+  name='CreateDatabaseRequest',
+  full_name='metastore.CreateDatabaseRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.CreateDatabaseRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='database', full_name='metastore.CreateDatabaseRequest.database', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.CreateDatabaseRequest.cookie', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1375,
+  serialized_end=1470,
+)
+
+
+_ALTERDATABASEREQUEST = _descriptor.Descriptor(
+  name='AlterDatabaseRequest',
+  full_name='metastore.AlterDatabaseRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.AlterDatabaseRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.AlterDatabaseRequest.id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='database', full_name='metastore.AlterDatabaseRequest.database', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.AlterDatabaseRequest.cookie', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1472,
+  serialized_end=1593,
+)
+
+
+_GETDATABASEREQUEST = _descriptor.Descriptor(
+  name='GetDatabaseRequest',
+  full_name='metastore.GetDatabaseRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.GetDatabaseRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.GetDatabaseRequest.id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.GetDatabaseRequest.cookie', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1595,
+  serialized_end=1675,
+)
+
+
+_GETDATABASERESPONSE = _descriptor.Descriptor(
+  name='GetDatabaseResponse',
+  full_name='metastore.GetDatabaseResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='database', full_name='metastore.GetDatabaseResponse.database', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='metastore.GetDatabaseResponse.status', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1677,
+  serialized_end=1779,
+)
+
+
+_LISTDATABASESREQUEST = _descriptor.Descriptor(
+  name='ListDatabasesRequest',
+  full_name='metastore.ListDatabasesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.ListDatabasesRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.ListDatabasesRequest.cookie', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name_pattern', full_name='metastore.ListDatabasesRequest.name_pattern', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='exclude_params', full_name='metastore.ListDatabasesRequest.exclude_params', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='fields', full_name='metastore.ListDatabasesRequest.fields', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='modified_since', full_name='metastore.ListDatabasesRequest.modified_since', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1782,
+  serialized_end=1923,
+)
+
+
+_DROPDATABASEREQUEST = _descriptor.Descriptor(
+  name='DropDatabaseRequest',
+  full_name='metastore.DropDatabaseRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.DropDatabaseRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.DropDatabaseRequest.id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.DropDatabaseRequest.cookie', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='purge', full_name='metastore.DropDatabaseRequest.purge', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1925,
+  serialized_end=2021,
+)
+
+
+_FIELDSCHEMA = _descriptor.Descriptor(
+  name='FieldSchema',
+  full_name='metastore.FieldSchema',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='metastore.FieldSchema.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='metastore.FieldSchema.type', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='comment', full_name='metastore.FieldSchema.comment', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2023,
+  serialized_end=2081,
+)
+
+
+_SERDEINFO_PARAMETERSENTRY = _descriptor.Descriptor(
+  name='ParametersEntry',
+  full_name='metastore.SerDeInfo.ParametersEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='metastore.SerDeInfo.ParametersEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='metastore.SerDeInfo.ParametersEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=489,
+  serialized_end=538,
+)
+
+_SERDEINFO = _descriptor.Descriptor(
+  name='SerDeInfo',
+  full_name='metastore.SerDeInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='metastore.SerDeInfo.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='metastore.SerDeInfo.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='serializationLib', full_name='metastore.SerDeInfo.serializationLib', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='parameters', full_name='metastore.SerDeInfo.parameters', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_SERDEINFO_PARAMETERSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2084,
+  serialized_end=2280,
+)
+
+
+_ORDER = _descriptor.Descriptor(
+  name='Order',
+  full_name='metastore.Order',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='col', full_name='metastore.Order.col', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ascending', full_name='metastore.Order.ascending', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2282,
+  serialized_end=2321,
+)
+
+
+_STORAGEDESCRIPTOR_PARAMETERSENTRY = _descriptor.Descriptor(
+  name='ParametersEntry',
+  full_name='metastore.StorageDescriptor.ParametersEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='metastore.StorageDescriptor.ParametersEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='metastore.StorageDescriptor.ParametersEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=489,
+  serialized_end=538,
+)
+
+_STORAGEDESCRIPTOR_SYSTEMPARAMETERSENTRY = _descriptor.Descriptor(
+  name='SystemParametersEntry',
+  full_name='metastore.StorageDescriptor.SystemParametersEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='metastore.StorageDescriptor.SystemParametersEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='metastore.StorageDescriptor.SystemParametersEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1318,
+  serialized_end=1373,
+)
+
+_STORAGEDESCRIPTOR = _descriptor.Descriptor(
+  name='StorageDescriptor',
+  full_name='metastore.StorageDescriptor',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cols', full_name='metastore.StorageDescriptor.cols', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='inputFormat', full_name='metastore.StorageDescriptor.inputFormat', index=1,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='inputFormatName', full_name='metastore.StorageDescriptor.inputFormatName', index=2,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='outputFormat', full_name='metastore.StorageDescriptor.outputFormat', index=3,
+      number=5, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='outputFormatName', full_name='metastore.StorageDescriptor.outputFormatName', index=4,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='numBuckets', full_name='metastore.StorageDescriptor.numBuckets', index=5,
+      number=7, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='serdeInfo', full_name='metastore.StorageDescriptor.serdeInfo', index=6,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bucketCols', full_name='metastore.StorageDescriptor.bucketCols', index=7,
+      number=9, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='sortCols', full_name='metastore.StorageDescriptor.sortCols', index=8,
+      number=10, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='parameters', full_name='metastore.StorageDescriptor.parameters', index=9,
+      number=11, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='system_parameters', full_name='metastore.StorageDescriptor.system_parameters', index=10,
+      number=12, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_STORAGEDESCRIPTOR_PARAMETERSENTRY, _STORAGEDESCRIPTOR_SYSTEMPARAMETERSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2324,
+  serialized_end=2894,
+)
+
+
+_TABLE_PARAMETERSENTRY = _descriptor.Descriptor(
+  name='ParametersEntry',
+  full_name='metastore.Table.ParametersEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='metastore.Table.ParametersEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='metastore.Table.ParametersEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=489,
+  serialized_end=538,
+)
+
+_TABLE_SYSTEMPARAMETERSENTRY = _descriptor.Descriptor(
+  name='SystemParametersEntry',
+  full_name='metastore.Table.SystemParametersEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='metastore.Table.SystemParametersEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='metastore.Table.SystemParametersEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1318,
+  serialized_end=1373,
+)
+
+_TABLE = _descriptor.Descriptor(
+  name='Table',
+  full_name='metastore.Table',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.Table.id', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='seq_id', full_name='metastore.Table.seq_id', index=1,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='sd', full_name='metastore.Table.sd', index=2,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='partitionKeys', full_name='metastore.Table.partitionKeys', index=3,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tableType', full_name='metastore.Table.tableType', index=4,
+      number=6, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='parameters', full_name='metastore.Table.parameters', index=5,
+      number=7, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='system_parameters', full_name='metastore.Table.system_parameters', index=6,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='location', full_name='metastore.Table.location', index=7,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='create_time', full_name='metastore.Table.create_time', index=8,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last_modified_time', full_name='metastore.Table.last_modified_time', index=9,
+      number=11, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last_access_time', full_name='metastore.Table.last_access_time', index=10,
+      number=12, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='created_by', full_name='metastore.Table.created_by', index=11,
+      number=13, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='modified_by', full_name='metastore.Table.modified_by', index=12,
+      number=14, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='schema_version', full_name='metastore.Table.schema_version', index=13,
+      number=15, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_TABLE_PARAMETERSENTRY, _TABLE_SYSTEMPARAMETERSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2897,
+  serialized_end=3464,
+)
+
+
+_TABLESCHEMA = _descriptor.Descriptor(
+  name='TableSchema',
+  full_name='metastore.TableSchema',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='version', full_name='metastore.TableSchema.version', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cols', full_name='metastore.TableSchema.cols', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='create_time', full_name='metastore.TableSchema.create_time', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='created_by', full_name='metastore.TableSchema.created_by', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3466,
+  serialized_end=3575,
+)
+
+
+_CREATETABLEREQUEST = _descriptor.Descriptor(
+  name='CreateTableRequest',
+  full_name='metastore.CreateTableRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.CreateTableRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.CreateTableRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table', full_name='metastore.CreateTableRequest.table', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.CreateTableRequest.cookie', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3577,
+  serialized_end=3693,
+)
+
+
+_GETTABLEREQUEST = _descriptor.Descriptor(
+  name='GetTableRequest',
+  full_name='metastore.GetTableRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.GetTableRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.GetTableRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.GetTableRequest.id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.GetTableRequest.cookie', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3695,
+  serialized_end=3802,
+)
+
+
+_GETTABLERESPONSE = _descriptor.Descriptor(
+  name='GetTableResponse',
+  full_name='metastore.GetTableResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='table', full_name='metastore.GetTableResponse.table', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='metastore.GetTableResponse.status', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3804,
+  serialized_end=3897,
+)
+
+
+_LISTTABLESREQUEST = _descriptor.Descriptor(
+  name='ListTablesRequest',
+  full_name='metastore.ListTablesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.ListTablesRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.ListTablesRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.ListTablesRequest.cookie', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='fields', full_name='metastore.ListTablesRequest.fields', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='modified_since', full_name='metastore.ListTablesRequest.modified_since', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3899,
+  serialized_end=4021,
+)
+
+
+_DROPTABLEREQUEST = _descriptor.Descriptor(
+  name='DropTableRequest',
+  full_name='metastore.DropTableRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.DropTableRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.DropTableRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.DropTableRequest.id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.DropTableRequest.cookie', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='purge', full_name='metastore.DropTableRequest.purge', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4023,
+  serialized_end=4146,
+)
+
+
+_DROPPEDOBJECT = _descriptor.Descriptor(
+  name='DroppedObject',
+  full_name='metastore.DroppedObject',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='kind', full_name='metastore.DroppedObject.kind', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.DroppedObject.id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.DroppedObject.db_id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='drop_time', full_name='metastore.DroppedObject.drop_time', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='expire_time', full_name='metastore.DroppedObject.expire_time', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dropped_by', full_name='metastore.DroppedObject.dropped_by', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _DROPPEDOBJECT_KIND,
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4149,
+  serialized_end=4375,
+)
+
+
+_LISTDROPPEDREQUEST = _descriptor.Descriptor(
+  name='ListDroppedRequest',
+  full_name='metastore.ListDroppedRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.ListDroppedRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.ListDroppedRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.ListDroppedRequest.cookie', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4377,
+  serialized_end=4460,
+)
+
+
+_UNDROPREQUEST = _descriptor.Descriptor(
+  name='UndropRequest',
+  full_name='metastore.UndropRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.UndropRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.UndropRequest.id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='new_name', full_name='metastore.UndropRequest.new_name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.UndropRequest.cookie', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4462,
+  serialized_end=4540,
+)
+
+
+_ALTERTABLEREQUEST = _descriptor.Descriptor(
+  name='AlterTableRequest',
+  full_name='metastore.AlterTableRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.AlterTableRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.AlterTableRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.AlterTableRequest.id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table', full_name='metastore.AlterTableRequest.table', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.AlterTableRequest.cookie', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='update_mask', full_name='metastore.AlterTableRequest.update_mask', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4543,
+  serialized_end=4706,
+)
+
+
+_SCHEMAINCOMPATIBILITY = _descriptor.Descriptor(
+  name='SchemaIncompatibility',
+  full_name='metastore.SchemaIncompatibility',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='kind', full_name='metastore.SchemaIncompatibility.kind', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='column', full_name='metastore.SchemaIncompatibility.column', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='old_type', full_name='metastore.SchemaIncompatibility.old_type', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='new_type', full_name='metastore.SchemaIncompatibility.new_type', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='message', full_name='metastore.SchemaIncompatibility.message', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _SCHEMAINCOMPATIBILITY_KIND,
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4709,
+  serialized_end=4935,
+)
+
+
+_ADDCOLUMNSREQUEST = _descriptor.Descriptor(
+  name='AddColumnsRequest',
+  full_name='metastore.AddColumnsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.AddColumnsRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.AddColumnsRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.AddColumnsRequest.id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cols', full_name='metastore.AddColumnsRequest.cols', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cascade', full_name='metastore.AddColumnsRequest.cascade', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.AddColumnsRequest.cookie', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4938,
+  serialized_end=5102,
+)
+
+
+_REPLACECOLUMNSREQUEST = _descriptor.Descriptor(
+  name='ReplaceColumnsRequest',
+  full_name='metastore.ReplaceColumnsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.ReplaceColumnsRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.ReplaceColumnsRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.ReplaceColumnsRequest.id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cols', full_name='metastore.ReplaceColumnsRequest.cols', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cascade', full_name='metastore.ReplaceColumnsRequest.cascade', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.ReplaceColumnsRequest.cookie', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5105,
+  serialized_end=5273,
+)
+
+
+_CHANGECOLUMNREQUEST = _descriptor.Descriptor(
+  name='ChangeColumnRequest',
+  full_name='metastore.ChangeColumnRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.ChangeColumnRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.ChangeColumnRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.ChangeColumnRequest.id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='metastore.ChangeColumnRequest.name', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='column', full_name='metastore.ChangeColumnRequest.column', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='first', full_name='metastore.ChangeColumnRequest.first', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='after', full_name='metastore.ChangeColumnRequest.after', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cascade', full_name='metastore.ChangeColumnRequest.cascade', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.ChangeColumnRequest.cookie', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5276,
+  serialized_end=5488,
+)
+
+
+_DROPCOLUMNREQUEST = _descriptor.Descriptor(
+  name='DropColumnRequest',
+  full_name='metastore.DropColumnRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.DropColumnRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.DropColumnRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.DropColumnRequest.id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='metastore.DropColumnRequest.name', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cascade', full_name='metastore.DropColumnRequest.cascade', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.DropColumnRequest.cookie', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5491,
+  serialized_end=5631,
+)
+
+
+_ALTERTABLERESPONSE = _descriptor.Descriptor(
+  name='AlterTableResponse',
+  full_name='metastore.AlterTableResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='table', full_name='metastore.AlterTableResponse.table', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='metastore.AlterTableResponse.status', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='incompatibilities', full_name='metastore.AlterTableResponse.incompatibilities', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5634,
+  serialized_end=5790,
+)
+
+
+_PARTITION_PARAMETERSENTRY = _descriptor.Descriptor(
+  name='ParametersEntry',
+  full_name='metastore.Partition.ParametersEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='metastore.Partition.ParametersEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='metastore.Partition.ParametersEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=489,
+  serialized_end=538,
+)
+
+_PARTITION = _descriptor.Descriptor(
+  name='Partition',
+  full_name='metastore.Partition',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='metastore.Partition.id', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='seq_id', full_name='metastore.Partition.seq_id', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='values', full_name='metastore.Partition.values', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='sd', full_name='metastore.Partition.sd', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='parameters', full_name='metastore.Partition.parameters', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='location', full_name='metastore.Partition.location', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table', full_name='metastore.Partition.table', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='create_time', full_name='metastore.Partition.create_time', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last_modified_time', full_name='metastore.Partition.last_modified_time', index=8,
+      number=9, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last_access_time', full_name='metastore.Partition.last_access_time', index=9,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='created_by', full_name='metastore.Partition.created_by', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='modified_by', full_name='metastore.Partition.modified_by', index=11,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='inherited_sd_fields', full_name='metastore.Partition.inherited_sd_fields', index=12,
+      number=13, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='schema_version', full_name='metastore.Partition.schema_version', index=13,
+      number=14, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_PARTITION_PARAMETERSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5793,
+  serialized_end=6234,
+)
+
+
+_ADDPARTITIONREQUEST = _descriptor.Descriptor(
+  name='AddPartitionRequest',
+  full_name='metastore.AddPartitionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sequence', full_name='metastore.AddPartitionRequest.sequence', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='catalog', full_name='metastore.AddPartitionRequest.catalog', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.AddPartitionRequest.db_id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table_id', full_name='metastore.AddPartitionRequest.table_id', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='partition', full_name='metastore.AddPartitionRequest.partition', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6237,
+  serialized_end=6397,
+)
+
+
+_ADDPARTITIONRESPONSE = _descriptor.Descriptor(
+  name='AddPartitionResponse',
+  full_name='metastore.AddPartitionResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='col', full_name='metastore.Order.col', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='sequence', full_name='metastore.AddPartitionResponse.sequence', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='ascending', full_name='metastore.Order.ascending', index=1,
-      number=2, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='status', full_name='metastore.AddPartitionResponse.status', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -829,66 +2938,102 @@ _ORDER = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1384,
-  serialized_end=1423,
+  serialized_start=6399,
+  serialized_end=6481,
 )
 
 
-_STORAGEDESCRIPTOR_PARAMETERSENTRY = _descriptor.Descriptor(
-  name='ParametersEntry',
-  full_name='metastore.StorageDescriptor.ParametersEntry',
+_GETPARTITIONREQUEST = _descriptor.Descriptor(
+  name='GetPartitionRequest',
+  full_name='metastore.GetPartitionRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='metastore.StorageDescriptor.ParametersEntry.key', index=0,
+      name='catalog', full_name='metastore.GetPartitionRequest.catalog', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='metastore.StorageDescriptor.ParametersEntry.value', index=1,
-      number=2, type=9, cpp_type=9, label=1,
+      name='db_id', full_name='metastore.GetPartitionRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table_id', full_name='metastore.GetPartitionRequest.table_id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='values', full_name='metastore.GetPartitionRequest.values', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='metastore.GetPartitionRequest.name', index=4,
+      number=5, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='effective_schema', full_name='metastore.GetPartitionRequest.effective_schema', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=548,
-  serialized_end=597,
+  serialized_start=6484,
+  serialized_end=6641,
 )
 
-_STORAGEDESCRIPTOR_SYSTEMPARAMETERSENTRY = _descriptor.Descriptor(
-  name='SystemParametersEntry',
-  full_name='metastore.StorageDescriptor.SystemParametersEntry',
+
+_GETPARTITIONRESPONSE = _descriptor.Descriptor(
+  name='GetPartitionResponse',
+  full_name='metastore.GetPartitionResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='metastore.StorageDescriptor.SystemParametersEntry.key', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='partition', full_name='metastore.GetPartitionResponse.partition', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='metastore.StorageDescriptor.SystemParametersEntry.value', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='status', full_name='metastore.GetPartitionResponse.status', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='schema', full_name='metastore.GetPartitionResponse.schema', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -898,142 +3043,119 @@ _STORAGEDESCRIPTOR_SYSTEMPARAMETERSENTRY = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=599,
-  serialized_end=654,
+  serialized_start=6644,
+  serialized_end=6789,
 )
 
-_STORAGEDESCRIPTOR = _descriptor.Descriptor(
-  name='StorageDescriptor',
-  full_name='metastore.StorageDescriptor',
+
+_LISTPARTITIONSREQUEST = _descriptor.Descriptor(
+  name='ListPartitionsRequest',
+  full_name='metastore.ListPartitionsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cols', full_name='metastore.StorageDescriptor.cols', index=0,
-      number=1, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='catalog', full_name='metastore.ListPartitionsRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='location', full_name='metastore.StorageDescriptor.location', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='db_id', full_name='metastore.ListPartitionsRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='inputFormat', full_name='metastore.StorageDescriptor.inputFormat', index=2,
-      number=3, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='table_id', full_name='metastore.ListPartitionsRequest.table_id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='inputFormatName', full_name='metastore.StorageDescriptor.inputFormatName', index=3,
+      name='cookie', full_name='metastore.ListPartitionsRequest.cookie', index=3,
       number=4, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='outputFormat', full_name='metastore.StorageDescriptor.outputFormat', index=4,
-      number=5, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='fields', full_name='metastore.ListPartitionsRequest.fields', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='outputFormatName', full_name='metastore.StorageDescriptor.outputFormatName', index=5,
-      number=6, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='values', full_name='metastore.ListPartitionsRequest.values', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='numBuckets', full_name='metastore.StorageDescriptor.numBuckets', index=6,
-      number=7, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='exclude', full_name='metastore.ListPartitionsRequest.exclude', index=6,
+      number=7, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='serdeInfo', full_name='metastore.StorageDescriptor.serdeInfo', index=7,
-      number=8, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='modified_since', full_name='metastore.ListPartitionsRequest.modified_since', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='bucketCols', full_name='metastore.StorageDescriptor.bucketCols', index=8,
+      name='names', full_name='metastore.ListPartitionsRequest.names', index=8,
       number=9, type=9, cpp_type=9, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='sortCols', full_name='metastore.StorageDescriptor.sortCols', index=9,
-      number=10, type=11, cpp_type=10, label=3,
+      name='from', full_name='metastore.ListPartitionsRequest.from', index=9,
+      number=10, type=9, cpp_type=9, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='parameters', full_name='metastore.StorageDescriptor.parameters', index=10,
-      number=11, type=11, cpp_type=10, label=3,
+      name='to', full_name='metastore.ListPartitionsRequest.to', index=10,
+      number=11, type=9, cpp_type=9, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='system_parameters', full_name='metastore.StorageDescriptor.system_parameters', index=11,
-      number=12, type=11, cpp_type=10, label=3,
+      name='prefix', full_name='metastore.ListPartitionsRequest.prefix', index=11,
+      number=12, type=9, cpp_type=9, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
-  ],
-  extensions=[
-  ],
-  nested_types=[_STORAGEDESCRIPTOR_PARAMETERSENTRY, _STORAGEDESCRIPTOR_SYSTEMPARAMETERSENTRY, ],
-  enum_types=[
-  ],
-  options=None,
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=1426,
-  serialized_end=2014,
-)
-
-
-_TABLE_PARAMETERSENTRY = _descriptor.Descriptor(
-  name='ParametersEntry',
-  full_name='metastore.Table.ParametersEntry',
-  filename=None,
-  file=DESCRIPTOR,
-  containing_type=None,
-  fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='metastore.Table.ParametersEntry.key', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='descending', full_name='metastore.ListPartitionsRequest.descending', index=12,
+      number=13, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='metastore.Table.ParametersEntry.value', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='compact', full_name='metastore.ListPartitionsRequest.compact', index=13,
+      number=14, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -1043,34 +3165,28 @@ _TABLE_PARAMETERSENTRY = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=548,
-  serialized_end=597,
+  serialized_start=6792,
+  serialized_end=7106,
 )
 
-_TABLE_SYSTEMPARAMETERSENTRY = _descriptor.Descriptor(
-  name='SystemParametersEntry',
-  full_name='metastore.Table.SystemParametersEntry',
+
+_PARTITIONVALUES = _descriptor.Descriptor(
+  name='PartitionValues',
+  full_name='metastore.PartitionValues',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='metastore.Table.SystemParametersEntry.key', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='value', full_name='metastore.Table.SystemParametersEntry.value', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='value', full_name='metastore.PartitionValues.value', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -1080,68 +3196,69 @@ _TABLE_SYSTEMPARAMETERSENTRY = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=599,
-  serialized_end=654,
+  serialized_start=7108,
+  serialized_end=7140,
 )
 
-_TABLE = _descriptor.Descriptor(
-  name='Table',
-  full_name='metastore.Table',
+
+_DROPPARTITIONSREQUEST = _descriptor.Descriptor(
+  name='DropPartitionsRequest',
+  full_name='metastore.DropPartitionsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='id', full_name='metastore.Table.id', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='catalog', full_name='metastore.DropPartitionsRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='seq_id', full_name='metastore.Table.seq_id', index=1,
-      number=3, type=4, cpp_type=4, label=1,
-      has_default_value=False, default_value=0,
+      name='db_id', full_name='metastore.DropPartitionsRequest.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='sd', full_name='metastore.Table.sd', index=2,
-      number=4, type=11, cpp_type=10, label=1,
+      name='table_id', full_name='metastore.DropPartitionsRequest.table_id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='partitionKeys', full_name='metastore.Table.partitionKeys', index=3,
-      number=5, type=11, cpp_type=10, label=3,
+      name='values', full_name='metastore.DropPartitionsRequest.values', index=3,
+      number=4, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='tableType', full_name='metastore.Table.tableType', index=4,
-      number=6, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='cookie', full_name='metastore.DropPartitionsRequest.cookie', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='parameters', full_name='metastore.Table.parameters', index=5,
-      number=7, type=11, cpp_type=10, label=3,
+      name='names', full_name='metastore.DropPartitionsRequest.names', index=5,
+      number=6, type=9, cpp_type=9, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='system_parameters', full_name='metastore.Table.system_parameters', index=6,
-      number=8, type=11, cpp_type=10, label=3,
+      name='prefix', full_name='metastore.DropPartitionsRequest.prefix', index=6,
+      number=7, type=9, cpp_type=9, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -1149,7 +3266,7 @@ _TABLE = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[_TABLE_PARAMETERSENTRY, _TABLE_SYSTEMPARAMETERSENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   options=None,
@@ -1158,46 +3275,53 @@ _TABLE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2017,
-  serialized_end=2426,
+  serialized_start=7143,
+  serialized_end=7337,
 )
 
 
-_CREATETABLEREQUEST = _descriptor.Descriptor(
-  name='CreateTableRequest',
-  full_name='metastore.CreateTableRequest',
+_GETPARTITIONNAMESREQUEST = _descriptor.Descriptor(
+  name='GetPartitionNamesRequest',
+  full_name='metastore.GetPartitionNamesRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='catalog', full_name='metastore.CreateTableRequest.catalog', index=0,
+      name='catalog', full_name='metastore.GetPartitionNamesRequest.catalog', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='db_id', full_name='metastore.CreateTableRequest.db_id', index=1,
+      name='db_id', full_name='metastore.GetPartitionNamesRequest.db_id', index=1,
       number=2, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='table', full_name='metastore.CreateTableRequest.table', index=2,
+      name='table_id', full_name='metastore.GetPartitionNamesRequest.table_id', index=2,
       number=3, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='cookie', full_name='metastore.CreateTableRequest.cookie', index=3,
+      name='cookie', full_name='metastore.GetPartitionNamesRequest.cookie', index=3,
       number=4, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='max_parts', full_name='metastore.GetPartitionNamesRequest.max_parts', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -1210,42 +3334,73 @@ _CREATETABLEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2428,
-  serialized_end=2544,
+  serialized_start=7340,
+  serialized_end=7481,
 )
 
 
-_GETTABLEREQUEST = _descriptor.Descriptor(
-  name='GetTableRequest',
-  full_name='metastore.GetTableRequest',
+_GETPARTITIONNAMESRESPONSE = _descriptor.Descriptor(
+  name='GetPartitionNamesResponse',
+  full_name='metastore.GetPartitionNamesResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='catalog', full_name='metastore.GetTableRequest.catalog', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='names', full_name='metastore.GetPartitionNamesResponse.names', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='db_id', full_name='metastore.GetTableRequest.db_id', index=1,
+      name='status', full_name='metastore.GetPartitionNamesResponse.status', index=1,
       number=2, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7483,
+  serialized_end=7567,
+)
+
+
+_RESOLVEIDREQUEST = _descriptor.Descriptor(
+  name='ResolveIdRequest',
+  full_name='metastore.ResolveIdRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='id', full_name='metastore.GetTableRequest.id', index=2,
-      number=3, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='catalog', full_name='metastore.ResolveIdRequest.catalog', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='cookie', full_name='metastore.GetTableRequest.cookie', index=3,
-      number=4, type=9, cpp_type=9, label=1,
+      name='id', full_name='metastore.ResolveIdRequest.id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cookie', full_name='metastore.ResolveIdRequest.cookie', index=2,
+      number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -1262,28 +3417,70 @@ _GETTABLEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2546,
-  serialized_end=2653,
+  serialized_start=7569,
+  serialized_end=7632,
 )
 
 
-_GETTABLERESPONSE = _descriptor.Descriptor(
-  name='GetTableResponse',
-  full_name='metastore.GetTableResponse',
+_RESOLVEIDRESPONSE = _descriptor.Descriptor(
+  name='ResolveIdResponse',
+  full_name='metastore.ResolveIdResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='table', full_name='metastore.GetTableResponse.table', index=0,
-      number=1, type=11, cpp_type=10, label=1,
+      name='kind', full_name='metastore.ResolveIdResponse.kind', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='db_id', full_name='metastore.ResolveIdResponse.db_id', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table_id', full_name='metastore.ResolveIdResponse.table_id', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='values', full_name='metastore.ResolveIdResponse.values', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='database', full_name='metastore.ResolveIdResponse.database', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table', full_name='metastore.ResolveIdResponse.table', index=5,
+      number=6, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='status', full_name='metastore.GetTableResponse.status', index=1,
-      number=2, type=11, cpp_type=10, label=1,
+      name='partition', full_name='metastore.ResolveIdResponse.partition', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='metastore.ResolveIdResponse.status', index=7,
+      number=8, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -1293,6 +3490,7 @@ _GETTABLERESPONSE = _descriptor.Descriptor(
   ],
   nested_types=[],
   enum_types=[
+    _RESOLVEIDRESPONSE_KIND,
   ],
   options=None,
   is_extendable=False,
@@ -1300,35 +3498,28 @@ _GETTABLERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2655,
-  serialized_end=2748,
+  serialized_start=7635,
+  serialized_end=8018,
 )
 
 
-_LISTTABLESREQUEST = _descriptor.Descriptor(
-  name='ListTablesRequest',
-  full_name='metastore.ListTablesRequest',
+_BACKUPREQUEST = _descriptor.Descriptor(
+  name='BackupRequest',
+  full_name='metastore.BackupRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='catalog', full_name='metastore.ListTablesRequest.catalog', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='db_id', full_name='metastore.ListTablesRequest.db_id', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='chunk_size', full_name='metastore.BackupRequest.chunk_size', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='cookie', full_name='metastore.ListTablesRequest.cookie', index=2,
-      number=3, type=9, cpp_type=9, label=1,
+      name='cookie', full_name='metastore.BackupRequest.cookie', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -1345,43 +3536,36 @@ _LISTTABLESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2750,
-  serialized_end=2832,
+  serialized_start=8020,
+  serialized_end=8071,
 )
 
 
-_DROPTABLEREQUEST = _descriptor.Descriptor(
-  name='DropTableRequest',
-  full_name='metastore.DropTableRequest',
+_BACKUPCHUNK = _descriptor.Descriptor(
+  name='BackupChunk',
+  full_name='metastore.BackupChunk',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='catalog', full_name='metastore.DropTableRequest.catalog', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='db_id', full_name='metastore.DropTableRequest.db_id', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='data', full_name='metastore.BackupChunk.data', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='id', full_name='metastore.DropTableRequest.id', index=2,
-      number=3, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='size', full_name='metastore.BackupChunk.size', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='cookie', full_name='metastore.DropTableRequest.cookie', index=3,
-      number=4, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='tx_id', full_name='metastore.BackupChunk.tx_id', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -1397,18 +3581,26 @@ _DROPTABLEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2834,
-  serialized_end=2942,
+  serialized_start=8073,
+  serialized_end=8129,
 )
 
 _REQUESTSTATUS.fields_by_name['status'].enum_type = _REQUESTSTATUS_STATUS
 _REQUESTSTATUS_STATUS.containing_type = _REQUESTSTATUS
+_CATALOG_PARAMETERSENTRY.containing_type = _CATALOG
+_CATALOG.fields_by_name['parameters'].message_type = _CATALOG_PARAMETERSENTRY
+_CREATECATALOGREQUEST.fields_by_name['catalog'].message_type = _CATALOG
+_GETCATALOGRESPONSE.fields_by_name['catalog'].message_type = _CATALOG
+_GETCATALOGRESPONSE.fields_by_name['status'].message_type = _REQUESTSTATUS
+_ALTERCATALOGREQUEST.fields_by_name['catalog'].message_type = _CATALOG
 _DATABASE_PARAMETERSENTRY.containing_type = _DATABASE
 _DATABASE_SYSTEMPARAMETERSENTRY.containing_type = _DATABASE
 _DATABASE.fields_by_name['id'].message_type = _ID
 _DATABASE.fields_by_name['parameters'].message_type = _DATABASE_PARAMETERSENTRY
 _DATABASE.fields_by_name['system_parameters'].message_type = _DATABASE_SYSTEMPARAMETERSENTRY
 _CREATEDATABASEREQUEST.fields_by_name['database'].message_type = _DATABASE
+_ALTERDATABASEREQUEST.fields_by_name['id'].message_type = _ID
+_ALTERDATABASEREQUEST.fields_by_name['database'].message_type = _DATABASE
 _GETDATABASEREQUEST.fields_by_name['id'].message_type = _ID
 _GETDATABASERESPONSE.fields_by_name['database'].message_type = _DATABASE
 _GETDATABASERESPONSE.fields_by_name['status'].message_type = _REQUESTSTATUS
@@ -1433,6 +3625,7 @@ _TABLE.fields_by_name['partitionKeys'].message_type = _FIELDSCHEMA
 _TABLE.fields_by_name['tableType'].enum_type = _TABLETYPE
 _TABLE.fields_by_name['parameters'].message_type = _TABLE_PARAMETERSENTRY
 _TABLE.fields_by_name['system_parameters'].message_type = _TABLE_SYSTEMPARAMETERSENTRY
+_TABLESCHEMA.fields_by_name['cols'].message_type = _FIELDSCHEMA
 _CREATETABLEREQUEST.fields_by_name['db_id'].message_type = _ID
 _CREATETABLEREQUEST.fields_by_name['table'].message_type = _TABLE
 _GETTABLEREQUEST.fields_by_name['db_id'].message_type = _ID
@@ -1442,10 +3635,73 @@ _GETTABLERESPONSE.fields_by_name['status'].message_type = _REQUESTSTATUS
 _LISTTABLESREQUEST.fields_by_name['db_id'].message_type = _ID
 _DROPTABLEREQUEST.fields_by_name['db_id'].message_type = _ID
 _DROPTABLEREQUEST.fields_by_name['id'].message_type = _ID
+_DROPPEDOBJECT.fields_by_name['kind'].enum_type = _DROPPEDOBJECT_KIND
+_DROPPEDOBJECT.fields_by_name['id'].message_type = _ID
+_DROPPEDOBJECT.fields_by_name['db_id'].message_type = _ID
+_DROPPEDOBJECT_KIND.containing_type = _DROPPEDOBJECT
+_LISTDROPPEDREQUEST.fields_by_name['db_id'].message_type = _ID
+_ALTERTABLEREQUEST.fields_by_name['db_id'].message_type = _ID
+_ALTERTABLEREQUEST.fields_by_name['id'].message_type = _ID
+_ALTERTABLEREQUEST.fields_by_name['table'].message_type = _TABLE
+_SCHEMAINCOMPATIBILITY.fields_by_name['kind'].enum_type = _SCHEMAINCOMPATIBILITY_KIND
+_SCHEMAINCOMPATIBILITY_KIND.containing_type = _SCHEMAINCOMPATIBILITY
+_ADDCOLUMNSREQUEST.fields_by_name['db_id'].message_type = _ID
+_ADDCOLUMNSREQUEST.fields_by_name['id'].message_type = _ID
+_ADDCOLUMNSREQUEST.fields_by_name['cols'].message_type = _FIELDSCHEMA
+_REPLACECOLUMNSREQUEST.fields_by_name['db_id'].message_type = _ID
+_REPLACECOLUMNSREQUEST.fields_by_name['id'].message_type = _ID
+_REPLACECOLUMNSREQUEST.fields_by_name['cols'].message_type = _FIELDSCHEMA
+_CHANGECOLUMNREQUEST.fields_by_name['db_id'].message_type = _ID
+_CHANGECOLUMNREQUEST.fields_by_name['id'].message_type = _ID
+_CHANGECOLUMNREQUEST.fields_by_name['column'].message_type = _FIELDSCHEMA
+_DROPCOLUMNREQUEST.fields_by_name['db_id'].message_type = _ID
+_DROPCOLUMNREQUEST.fields_by_name['id'].message_type = _ID
+_ALTERTABLERESPONSE.fields_by_name['table'].message_type = _TABLE
+_ALTERTABLERESPONSE.fields_by_name['status'].message_type = _REQUESTSTATUS
+_ALTERTABLERESPONSE.fields_by_name['incompatibilities'].message_type = _SCHEMAINCOMPATIBILITY
+_PARTITION_PARAMETERSENTRY.containing_type = _PARTITION
+_PARTITION.fields_by_name['id'].message_type = _ID
+_PARTITION.fields_by_name['sd'].message_type = _STORAGEDESCRIPTOR
+_PARTITION.fields_by_name['parameters'].message_type = _PARTITION_PARAMETERSENTRY
+_PARTITION.fields_by_name['table'].message_type = _TABLE
+_ADDPARTITIONREQUEST.fields_by_name['db_id'].message_type = _ID
+_ADDPARTITIONREQUEST.fields_by_name['table_id'].message_type = _ID
+_ADDPARTITIONREQUEST.fields_by_name['partition'].message_type = _PARTITION
+_ADDPARTITIONRESPONSE.fields_by_name['status'].message_type = _REQUESTSTATUS
+_GETPARTITIONREQUEST.fields_by_name['db_id'].message_type = _ID
+_GETPARTITIONREQUEST.fields_by_name['table_id'].message_type = _ID
+_GETPARTITIONRESPONSE.fields_by_name['partition'].message_type = _PARTITION
+_GETPARTITIONRESPONSE.fields_by_name['status'].message_type = _REQUESTSTATUS
+_GETPARTITIONRESPONSE.fields_by_name['schema'].message_type = _TABLESCHEMA
+_LISTPARTITIONSREQUEST.fields_by_name['db_id'].message_type = _ID
+_LISTPARTITIONSREQUEST.fields_by_name['table_id'].message_type = _ID
+_LISTPARTITIONSREQUEST.fields_by_name['values'].message_type = _PARTITIONVALUES
+_DROPPARTITIONSREQUEST.fields_by_name['db_id'].message_type = _ID
+_DROPPARTITIONSREQUEST.fields_by_name['table_id'].message_type = _ID
+_DROPPARTITIONSREQUEST.fields_by_name['values'].message_type = _PARTITIONVALUES
+_GETPARTITIONNAMESREQUEST.fields_by_name['db_id'].message_type = _ID
+_GETPARTITIONNAMESREQUEST.fields_by_name['table_id'].message_type = _ID
+_GETPARTITIONNAMESRESPONSE.fields_by_name['status'].message_type = _REQUESTSTATUS
+_RESOLVEIDRESPONSE.fields_by_name['kind'].enum_type = _RESOLVEIDRESPONSE_KIND
+_RESOLVEIDRESPONSE.fields_by_name['db_id'].message_type = _ID
+_RESOLVEIDRESPONSE.fields_by_name['table_id'].message_type = _ID
+_RESOLVEIDRESPONSE.fields_by_name['database'].message_type = _DATABASE
+_RESOLVEIDRESPONSE.fields_by_name['table'].message_type = _TABLE
+_RESOLVEIDRESPONSE.fields_by_name['partition'].message_type = _PARTITION
+_RESOLVEIDRESPONSE.fields_by_name['status'].message_type = _REQUESTSTATUS
+_RESOLVEIDRESPONSE_KIND.containing_type = _RESOLVEIDRESPONSE
 DESCRIPTOR.message_types_by_name['RequestStatus'] = _REQUESTSTATUS
 DESCRIPTOR.message_types_by_name['Id'] = _ID
+DESCRIPTOR.message_types_by_name['Catalog'] = _CATALOG
+DESCRIPTOR.message_types_by_name['CreateCatalogRequest'] = _CREATECATALOGREQUEST
+DESCRIPTOR.message_types_by_name['GetCatalogRequest'] = _GETCATALOGREQUEST
+DESCRIPTOR.message_types_by_name['GetCatalogResponse'] = _GETCATALOGRESPONSE
+DESCRIPTOR.message_types_by_name['ListCatalogsRequest'] = _LISTCATALOGSREQUEST
+DESCRIPTOR.message_types_by_name['AlterCatalogRequest'] = _ALTERCATALOGREQUEST
+DESCRIPTOR.message_types_by_name['DropCatalogRequest'] = _DROPCATALOGREQUEST
 DESCRIPTOR.message_types_by_name['Database'] = _DATABASE
 DESCRIPTOR.message_types_by_name['CreateDatabaseRequest'] = _CREATEDATABASEREQUEST
+DESCRIPTOR.message_types_by_name['AlterDatabaseRequest'] = _ALTERDATABASEREQUEST
 DESCRIPTOR.message_types_by_name['GetDatabaseRequest'] = _GETDATABASEREQUEST
 DESCRIPTOR.message_types_by_name['GetDatabaseResponse'] = _GETDATABASERESPONSE
 DESCRIPTOR.message_types_by_name['ListDatabasesRequest'] = _LISTDATABASESREQUEST
@@ -1455,15 +3711,41 @@ DESCRIPTOR.message_types_by_name['SerDeInfo'] = _SERDEINFO
 DESCRIPTOR.message_types_by_name['Order'] = _ORDER
 DESCRIPTOR.message_types_by_name['StorageDescriptor'] = _STORAGEDESCRIPTOR
 DESCRIPTOR.message_types_by_name['Table'] = _TABLE
+DESCRIPTOR.message_types_by_name['TableSchema'] = _TABLESCHEMA
 DESCRIPTOR.message_types_by_name['CreateTableRequest'] = _CREATETABLEREQUEST
 DESCRIPTOR.message_types_by_name['GetTableRequest'] = _GETTABLEREQUEST
 DESCRIPTOR.message_types_by_name['GetTableResponse'] = _GETTABLERESPONSE
 DESCRIPTOR.message_types_by_name['ListTablesRequest'] = _LISTTABLESREQUEST
 DESCRIPTOR.message_types_by_name['DropTableRequest'] = _DROPTABLEREQUEST
+DESCRIPTOR.message_types_by_name['DroppedObject'] = _DROPPEDOBJECT
+DESCRIPTOR.message_types_by_name['ListDroppedRequest'] = _LISTDROPPEDREQUEST
+DESCRIPTOR.message_types_by_name['UndropRequest'] = _UNDROPREQUEST
+DESCRIPTOR.message_types_by_name['AlterTableRequest'] = _ALTERTABLEREQUEST
+DESCRIPTOR.message_types_by_name['SchemaIncompatibility'] = _SCHEMAINCOMPATIBILITY
+DESCRIPTOR.message_types_by_name['AddColumnsRequest'] = _ADDCOLUMNSREQUEST
+DESCRIPTOR.message_types_by_name['ReplaceColumnsRequest'] = _REPLACECOLUMNSREQUEST
+DESCRIPTOR.message_types_by_name['ChangeColumnRequest'] = _CHANGECOLUMNREQUEST
+DESCRIPTOR.message_types_by_name['DropColumnRequest'] = _DROPCOLUMNREQUEST
+DESCRIPTOR.message_types_by_name['AlterTableResponse'] = _ALTERTABLERESPONSE
+DESCRIPTOR.message_types_by_name['Partition'] = _PARTITION
+DESCRIPTOR.message_types_by_name['AddPartitionRequest'] = _ADDPARTITIONREQUEST
+DESCRIPTOR.message_types_by_name['AddPartitionResponse'] = _ADDPARTITIONRESPONSE
+DESCRIPTOR.message_types_by_name['GetPartitionRequest'] = _GETPARTITIONREQUEST
+DESCRIPTOR.message_types_by_name['GetPartitionResponse'] = _GETPARTITIONRESPONSE
+DESCRIPTOR.message_types_by_name['ListPartitionsRequest'] = _LISTPARTITIONSREQUEST
+DESCRIPTOR.message_types_by_name['PartitionValues'] = _PARTITIONVALUES
+DESCRIPTOR.message_types_by_name['DropPartitionsRequest'] = _DROPPARTITIONSREQUEST
+DESCRIPTOR.message_types_by_name['GetPartitionNamesRequest'] = _GETPARTITIONNAMESREQUEST
+DESCRIPTOR.message_types_by_name['GetPartitionNamesResponse'] = _GETPARTITIONNAMESRESPONSE
+DESCRIPTOR.message_types_by_name['ResolveIdRequest'] = _RESOLVEIDREQUEST
+DESCRIPTOR.message_types_by_name['ResolveIdResponse'] = _RESOLVEIDRESPONSE
+DESCRIPTOR.message_types_by_name['BackupRequest'] = _BACKUPREQUEST
+DESCRIPTOR.message_types_by_name['BackupChunk'] = _BACKUPCHUNK
 DESCRIPTOR.enum_types_by_name['SerdeType'] = _SERDETYPE
 DESCRIPTOR.enum_types_by_name['InputFormat'] = _INPUTFORMAT
 DESCRIPTOR.enum_types_by_name['OutputFormat'] = _OUTPUTFORMAT
 DESCRIPTOR.enum_types_by_name['TableType'] = _TABLETYPE
+DESCRIPTOR.enum_types_by_name['SerializationLib'] = _SERIALIZATIONLIB
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 RequestStatus = _reflection.GeneratedProtocolMessageType('RequestStatus', (_message.Message,), dict(
@@ -1480,6 +3762,63 @@ Id = _reflection.GeneratedProtocolMessageType('Id', (_message.Message,), dict(
   ))
 _sym_db.RegisterMessage(Id)
 
+Catalog = _reflection.GeneratedProtocolMessageType('Catalog', (_message.Message,), dict(
+
+  ParametersEntry = _reflection.GeneratedProtocolMessageType('ParametersEntry', (_message.Message,), dict(
+    DESCRIPTOR = _CATALOG_PARAMETERSENTRY,
+    __module__ = 'metastore_pb2'
+    # @@protoc_insertion_point(class_scope:metastore.Catalog.ParametersEntry)
+    ))
+  ,
+  DESCRIPTOR = _CATALOG,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.Catalog)
+  ))
+_sym_db.RegisterMessage(Catalog)
+_sym_db.RegisterMessage(Catalog.ParametersEntry)
+
+CreateCatalogRequest = _reflection.GeneratedProtocolMessageType('CreateCatalogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CREATECATALOGREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.CreateCatalogRequest)
+  ))
+_sym_db.RegisterMessage(CreateCatalogRequest)
+
+GetCatalogRequest = _reflection.GeneratedProtocolMessageType('GetCatalogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETCATALOGREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.GetCatalogRequest)
+  ))
+_sym_db.RegisterMessage(GetCatalogRequest)
+
+GetCatalogResponse = _reflection.GeneratedProtocolMessageType('GetCatalogResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETCATALOGRESPONSE,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.GetCatalogResponse)
+  ))
+_sym_db.RegisterMessage(GetCatalogResponse)
+
+ListCatalogsRequest = _reflection.GeneratedProtocolMessageType('ListCatalogsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _LISTCATALOGSREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.ListCatalogsRequest)
+  ))
+_sym_db.RegisterMessage(ListCatalogsRequest)
+
+AlterCatalogRequest = _reflection.GeneratedProtocolMessageType('AlterCatalogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ALTERCATALOGREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.AlterCatalogRequest)
+  ))
+_sym_db.RegisterMessage(AlterCatalogRequest)
+
+DropCatalogRequest = _reflection.GeneratedProtocolMessageType('DropCatalogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _DROPCATALOGREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.DropCatalogRequest)
+  ))
+_sym_db.RegisterMessage(DropCatalogRequest)
+
 Database = _reflection.GeneratedProtocolMessageType('Database', (_message.Message,), dict(
 
   ParametersEntry = _reflection.GeneratedProtocolMessageType('ParametersEntry', (_message.Message,), dict(
@@ -1510,6 +3849,13 @@ CreateDatabaseRequest = _reflection.GeneratedProtocolMessageType('CreateDatabase
   ))
 _sym_db.RegisterMessage(CreateDatabaseRequest)
 
+AlterDatabaseRequest = _reflection.GeneratedProtocolMessageType('AlterDatabaseRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ALTERDATABASEREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.AlterDatabaseRequest)
+  ))
+_sym_db.RegisterMessage(AlterDatabaseRequest)
+
 GetDatabaseRequest = _reflection.GeneratedProtocolMessageType('GetDatabaseRequest', (_message.Message,), dict(
   DESCRIPTOR = _GETDATABASEREQUEST,
   __module__ = 'metastore_pb2'
@@ -1613,6 +3959,13 @@ _sym_db.RegisterMessage(Table)
 _sym_db.RegisterMessage(Table.ParametersEntry)
 _sym_db.RegisterMessage(Table.SystemParametersEntry)
 
+TableSchema = _reflection.GeneratedProtocolMessageType('TableSchema', (_message.Message,), dict(
+  DESCRIPTOR = _TABLESCHEMA,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.TableSchema)
+  ))
+_sym_db.RegisterMessage(TableSchema)
+
 CreateTableRequest = _reflection.GeneratedProtocolMessageType('CreateTableRequest', (_message.Message,), dict(
   DESCRIPTOR = _CREATETABLEREQUEST,
   __module__ = 'metastore_pb2'
@@ -1648,9 +4001,187 @@ DropTableRequest = _reflection.GeneratedProtocolMessageType('DropTableRequest',
   ))
 _sym_db.RegisterMessage(DropTableRequest)
 
+DroppedObject = _reflection.GeneratedProtocolMessageType('DroppedObject', (_message.Message,), dict(
+  DESCRIPTOR = _DROPPEDOBJECT,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.DroppedObject)
+  ))
+_sym_db.RegisterMessage(DroppedObject)
+
+ListDroppedRequest = _reflection.GeneratedProtocolMessageType('ListDroppedRequest', (_message.Message,), dict(
+  DESCRIPTOR = _LISTDROPPEDREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.ListDroppedRequest)
+  ))
+_sym_db.RegisterMessage(ListDroppedRequest)
+
+UndropRequest = _reflection.GeneratedProtocolMessageType('UndropRequest', (_message.Message,), dict(
+  DESCRIPTOR = _UNDROPREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.UndropRequest)
+  ))
+_sym_db.RegisterMessage(UndropRequest)
+
+AlterTableRequest = _reflection.GeneratedProtocolMessageType('AlterTableRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ALTERTABLEREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.AlterTableRequest)
+  ))
+_sym_db.RegisterMessage(AlterTableRequest)
+
+SchemaIncompatibility = _reflection.GeneratedProtocolMessageType('SchemaIncompatibility', (_message.Message,), dict(
+  DESCRIPTOR = _SCHEMAINCOMPATIBILITY,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.SchemaIncompatibility)
+  ))
+_sym_db.RegisterMessage(SchemaIncompatibility)
+
+AddColumnsRequest = _reflection.GeneratedProtocolMessageType('AddColumnsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ADDCOLUMNSREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.AddColumnsRequest)
+  ))
+_sym_db.RegisterMessage(AddColumnsRequest)
+
+ReplaceColumnsRequest = _reflection.GeneratedProtocolMessageType('ReplaceColumnsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _REPLACECOLUMNSREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.ReplaceColumnsRequest)
+  ))
+_sym_db.RegisterMessage(ReplaceColumnsRequest)
+
+ChangeColumnRequest = _reflection.GeneratedProtocolMessageType('ChangeColumnRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CHANGECOLUMNREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.ChangeColumnRequest)
+  ))
+_sym_db.RegisterMessage(ChangeColumnRequest)
+
+DropColumnRequest = _reflection.GeneratedProtocolMessageType('DropColumnRequest', (_message.Message,), dict(
+  DESCRIPTOR = _DROPCOLUMNREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.DropColumnRequest)
+  ))
+_sym_db.RegisterMessage(DropColumnRequest)
+
+AlterTableResponse = _reflection.GeneratedProtocolMessageType('AlterTableResponse', (_message.Message,), dict(
+  DESCRIPTOR = _ALTERTABLERESPONSE,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.AlterTableResponse)
+  ))
+_sym_db.RegisterMessage(AlterTableResponse)
+
+Partition = _reflection.GeneratedProtocolMessageType('Partition', (_message.Message,), dict(
+
+  ParametersEntry = _reflection.GeneratedProtocolMessageType('ParametersEntry', (_message.Message,), dict(
+    DESCRIPTOR = _PARTITION_PARAMETERSENTRY,
+    __module__ = 'metastore_pb2'
+    # @@protoc_insertion_point(class_scope:metastore.Partition.ParametersEntry)
+    ))
+  ,
+  DESCRIPTOR = _PARTITION,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.Partition)
+  ))
+_sym_db.RegisterMessage(Partition)
+_sym_db.RegisterMessage(Partition.ParametersEntry)
+
+AddPartitionRequest = _reflection.GeneratedProtocolMessageType('AddPartitionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ADDPARTITIONREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.AddPartitionRequest)
+  ))
+_sym_db.RegisterMessage(AddPartitionRequest)
+
+AddPartitionResponse = _reflection.GeneratedProtocolMessageType('AddPartitionResponse', (_message.Message,), dict(
+  DESCRIPTOR = _ADDPARTITIONRESPONSE,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.AddPartitionResponse)
+  ))
+_sym_db.RegisterMessage(AddPartitionResponse)
+
+GetPartitionRequest = _reflection.GeneratedProtocolMessageType('GetPartitionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETPARTITIONREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.GetPartitionRequest)
+  ))
+_sym_db.RegisterMessage(GetPartitionRequest)
+
+GetPartitionResponse = _reflection.GeneratedProtocolMessageType('GetPartitionResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETPARTITIONRESPONSE,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.GetPartitionResponse)
+  ))
+_sym_db.RegisterMessage(GetPartitionResponse)
+
+ListPartitionsRequest = _reflection.GeneratedProtocolMessageType('ListPartitionsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _LISTPARTITIONSREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.ListPartitionsRequest)
+  ))
+_sym_db.RegisterMessage(ListPartitionsRequest)
+
+PartitionValues = _reflection.GeneratedProtocolMessageType('PartitionValues', (_message.Message,), dict(
+  DESCRIPTOR = _PARTITIONVALUES,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.PartitionValues)
+  ))
+_sym_db.RegisterMessage(PartitionValues)
+
+DropPartitionsRequest = _reflection.GeneratedProtocolMessageType('DropPartitionsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _DROPPARTITIONSREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.DropPartitionsRequest)
+  ))
+_sym_db.RegisterMessage(DropPartitionsRequest)
+
+GetPartitionNamesRequest = _reflection.GeneratedProtocolMessageType('GetPartitionNamesRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETPARTITIONNAMESREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.GetPartitionNamesRequest)
+  ))
+_sym_db.RegisterMessage(GetPartitionNamesRequest)
+
+GetPartitionNamesResponse = _reflection.GeneratedProtocolMessageType('GetPartitionNamesResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETPARTITIONNAMESRESPONSE,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.GetPartitionNamesResponse)
+  ))
+_sym_db.RegisterMessage(GetPartitionNamesResponse)
+
+ResolveIdRequest = _reflection.GeneratedProtocolMessageType('ResolveIdRequest', (_message.Message,), dict(
+  DESCRIPTOR = _RESOLVEIDREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.ResolveIdRequest)
+  ))
+_sym_db.RegisterMessage(ResolveIdRequest)
+
+ResolveIdResponse = _reflection.GeneratedProtocolMessageType('ResolveIdResponse', (_message.Message,), dict(
+  DESCRIPTOR = _RESOLVEIDRESPONSE,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.ResolveIdResponse)
+  ))
+_sym_db.RegisterMessage(ResolveIdResponse)
+
+BackupRequest = _reflection.GeneratedProtocolMessageType('BackupRequest', (_message.Message,), dict(
+  DESCRIPTOR = _BACKUPREQUEST,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.BackupRequest)
+  ))
+_sym_db.RegisterMessage(BackupRequest)
+
+BackupChunk = _reflection.GeneratedProtocolMessageType('BackupChunk', (_message.Message,), dict(
+  DESCRIPTOR = _BACKUPCHUNK,
+  __module__ = 'metastore_pb2'
+  # @@protoc_insertion_point(class_scope:metastore.BackupChunk)
+  ))
+_sym_db.RegisterMessage(BackupChunk)
+
 
 DESCRIPTOR.has_options = True
-DESCRIPTOR._options = _descriptor._ParseOptions(descriptor_pb2.FileOptions(), _b('\n\023com.akolb.metastoreB\tMetaStoreP\001\222A\262\001\022j\n\022Hive Metastore Api\"O\n\026Hive Metastore Project\022\"https://github.com/akolb1/hmsv2api\032\021akolb1@google.com2\0031.0rD\n\036Metastore API V2 Documentation\022\"https://github.com/akolb1/hmsv2api'))
+DESCRIPTOR._options = _descriptor._ParseOptions(descriptor_pb2.FileOptions(), _b('\222A\262\001\022j\n\022Hive Metastore Api\"O\n\026Hive Metastore Project\022\"https://github.com/akolb1/hmsv2api\032\021akolb1@google.com2\0031.0rD\n\036Metastore API V2 Documentation\022\"https://github.com/akolb1/hmsv2api\n\023com.akolb.metastoreB\tMetaStoreP\001'))
+_CATALOG_PARAMETERSENTRY.has_options = True
+_CATALOG_PARAMETERSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _DATABASE_PARAMETERSENTRY.has_options = True
 _DATABASE_PARAMETERSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _DATABASE_SYSTEMPARAMETERSENTRY.has_options = True
@@ -1665,6 +4196,8 @@ _TABLE_PARAMETERSENTRY.has_options = True
 _TABLE_PARAMETERSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _TABLE_SYSTEMPARAMETERSENTRY.has_options = True
 _TABLE_SYSTEMPARAMETERSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_PARTITION_PARAMETERSENTRY.has_options = True
+_PARTITION_PARAMETERSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 
 _METASTORE = _descriptor.ServiceDescriptor(
   name='Metastore',
@@ -1672,13 +4205,58 @@ _METASTORE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   options=None,
-  serialized_start=3338,
-  serialized_end=4090,
+  serialized_start=8628,
+  serialized_end=11268,
   methods=[
+  _descriptor.MethodDescriptor(
+    name='CreateCatalog',
+    full_name='metastore.Metastore.CreateCatalog',
+    index=0,
+    containing_service=None,
+    input_type=_CREATECATALOGREQUEST,
+    output_type=_GETCATALOGRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetCatalog',
+    full_name='metastore.Metastore.GetCatalog',
+    index=1,
+    containing_service=None,
+    input_type=_GETCATALOGREQUEST,
+    output_type=_GETCATALOGRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\024\022\022/v2/catalog/{name}')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListCatalogs',
+    full_name='metastore.Metastore.ListCatalogs',
+    index=2,
+    containing_service=None,
+    input_type=_LISTCATALOGSREQUEST,
+    output_type=_CATALOG,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\r\022\013/v2/catalog')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='AlterCatalog',
+    full_name='metastore.Metastore.AlterCatalog',
+    index=3,
+    containing_service=None,
+    input_type=_ALTERCATALOGREQUEST,
+    output_type=_GETCATALOGRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DropCatalog',
+    full_name='metastore.Metastore.DropCatalog',
+    index=4,
+    containing_service=None,
+    input_type=_DROPCATALOGREQUEST,
+    output_type=_REQUESTSTATUS,
+    options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='CreateDabatase',
     full_name='metastore.Metastore.CreateDabatase',
-    index=0,
+    index=5,
     containing_service=None,
     input_type=_CREATEDATABASEREQUEST,
     output_type=_GETDATABASERESPONSE,
@@ -1687,7 +4265,7 @@ _METASTORE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetDatabase',
     full_name='metastore.Metastore.GetDatabase',
-    index=1,
+    index=6,
     containing_service=None,
     input_type=_GETDATABASEREQUEST,
     output_type=_GETDATABASERESPONSE,
@@ -1696,7 +4274,7 @@ _METASTORE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='ListDatabases',
     full_name='metastore.Metastore.ListDatabases',
-    index=2,
+    index=7,
     containing_service=None,
     input_type=_LISTDATABASESREQUEST,
     output_type=_DATABASE,
@@ -1705,16 +4283,25 @@ _METASTORE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='DropDatabase',
     full_name='metastore.Metastore.DropDatabase',
-    index=3,
+    index=8,
     containing_service=None,
     input_type=_DROPDATABASEREQUEST,
     output_type=_REQUESTSTATUS,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='AlterDatabase',
+    full_name='metastore.Metastore.AlterDatabase',
+    index=9,
+    containing_service=None,
+    input_type=_ALTERDATABASEREQUEST,
+    output_type=_GETDATABASERESPONSE,
+    options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='CreateTable',
     full_name='metastore.Metastore.CreateTable',
-    index=4,
+    index=10,
     containing_service=None,
     input_type=_CREATETABLEREQUEST,
     output_type=_GETTABLERESPONSE,
@@ -1723,7 +4310,7 @@ _METASTORE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetTable',
     full_name='metastore.Metastore.GetTable',
-    index=5,
+    index=11,
     containing_service=None,
     input_type=_GETTABLEREQUEST,
     output_type=_GETTABLERESPONSE,
@@ -1732,7 +4319,7 @@ _METASTORE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='ListTables',
     full_name='metastore.Metastore.ListTables',
-    index=6,
+    index=12,
     containing_service=None,
     input_type=_LISTTABLESREQUEST,
     output_type=_TABLE,
@@ -1741,12 +4328,147 @@ _METASTORE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='DropTable',
     full_name='metastore.Metastore.DropTable',
-    index=7,
+    index=13,
     containing_service=None,
     input_type=_DROPTABLEREQUEST,
     output_type=_REQUESTSTATUS,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='AlterTable',
+    full_name='metastore.Metastore.AlterTable',
+    index=14,
+    containing_service=None,
+    input_type=_ALTERTABLEREQUEST,
+    output_type=_ALTERTABLERESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='AddColumns',
+    full_name='metastore.Metastore.AddColumns',
+    index=15,
+    containing_service=None,
+    input_type=_ADDCOLUMNSREQUEST,
+    output_type=_ALTERTABLERESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ReplaceColumns',
+    full_name='metastore.Metastore.ReplaceColumns',
+    index=16,
+    containing_service=None,
+    input_type=_REPLACECOLUMNSREQUEST,
+    output_type=_ALTERTABLERESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ChangeColumn',
+    full_name='metastore.Metastore.ChangeColumn',
+    index=17,
+    containing_service=None,
+    input_type=_CHANGECOLUMNREQUEST,
+    output_type=_ALTERTABLERESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DropColumn',
+    full_name='metastore.Metastore.DropColumn',
+    index=18,
+    containing_service=None,
+    input_type=_DROPCOLUMNREQUEST,
+    output_type=_ALTERTABLERESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListDropped',
+    full_name='metastore.Metastore.ListDropped',
+    index=19,
+    containing_service=None,
+    input_type=_LISTDROPPEDREQUEST,
+    output_type=_DROPPEDOBJECT,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Undrop',
+    full_name='metastore.Metastore.Undrop',
+    index=20,
+    containing_service=None,
+    input_type=_UNDROPREQUEST,
+    output_type=_REQUESTSTATUS,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='AddPartition',
+    full_name='metastore.Metastore.AddPartition',
+    index=21,
+    containing_service=None,
+    input_type=_ADDPARTITIONREQUEST,
+    output_type=_ADDPARTITIONRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='AddManyPartitions',
+    full_name='metastore.Metastore.AddManyPartitions',
+    index=22,
+    containing_service=None,
+    input_type=_ADDPARTITIONREQUEST,
+    output_type=_ADDPARTITIONRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetPartition',
+    full_name='metastore.Metastore.GetPartition',
+    index=23,
+    containing_service=None,
+    input_type=_GETPARTITIONREQUEST,
+    output_type=_GETPARTITIONRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\0026\0224/v2/partition/{catalog}/{db_id.name}/{table_id.name}')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListPartitions',
+    full_name='metastore.Metastore.ListPartitions',
+    index=24,
+    containing_service=None,
+    input_type=_LISTPARTITIONSREQUEST,
+    output_type=_PARTITION,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\0027\0225/v2/partitions/{catalog}/{db_id.name}/{table_id.name}')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetPartitionNames',
+    full_name='metastore.Metastore.GetPartitionNames',
+    index=25,
+    containing_service=None,
+    input_type=_GETPARTITIONNAMESREQUEST,
+    output_type=_GETPARTITIONNAMESRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\0026\0224/v2/partnames/{catalog}/{db_id.name}/{table_id.name}')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='DropPartitions',
+    full_name='metastore.Metastore.DropPartitions',
+    index=26,
+    containing_service=None,
+    input_type=_DROPPARTITIONSREQUEST,
+    output_type=_REQUESTSTATUS,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ResolveId',
+    full_name='metastore.Metastore.ResolveId',
+    index=27,
+    containing_service=None,
+    input_type=_RESOLVEIDREQUEST,
+    output_type=_RESOLVEIDRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\027\022\025/v2/id/{catalog}/{id}')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Backup',
+    full_name='metastore.Metastore.Backup',
+    index=28,
+    containing_service=None,
+    input_type=_BACKUPREQUEST,
+    output_type=_BACKUPCHUNK,
+    options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_METASTORE)
 
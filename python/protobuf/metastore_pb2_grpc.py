@@ -26,6 +26,31 @@ class MetastoreStub(object):
     Args:
       channel: A grpc.Channel.
     """
+    self.CreateCatalog = channel.unary_unary(
+        '/metastore.Metastore/CreateCatalog',
+        request_serializer=metastore__pb2.CreateCatalogRequest.SerializeToString,
+        response_deserializer=metastore__pb2.GetCatalogResponse.FromString,
+        )
+    self.GetCatalog = channel.unary_unary(
+        '/metastore.Metastore/GetCatalog',
+        request_serializer=metastore__pb2.GetCatalogRequest.SerializeToString,
+        response_deserializer=metastore__pb2.GetCatalogResponse.FromString,
+        )
+    self.ListCatalogs = channel.unary_stream(
+        '/metastore.Metastore/ListCatalogs',
+        request_serializer=metastore__pb2.ListCatalogsRequest.SerializeToString,
+        response_deserializer=metastore__pb2.Catalog.FromString,
+        )
+    self.AlterCatalog = channel.unary_unary(
+        '/metastore.Metastore/AlterCatalog',
+        request_serializer=metastore__pb2.AlterCatalogRequest.SerializeToString,
+        response_deserializer=metastore__pb2.GetCatalogResponse.FromString,
+        )
+    self.DropCatalog = channel.unary_unary(
+        '/metastore.Metastore/DropCatalog',
+        request_serializer=metastore__pb2.DropCatalogRequest.SerializeToString,
+        response_deserializer=metastore__pb2.RequestStatus.FromString,
+        )
     self.CreateDabatase = channel.unary_unary(
         '/metastore.Metastore/CreateDabatase',
         request_serializer=metastore__pb2.CreateDatabaseRequest.SerializeToString,
@@ -46,6 +71,11 @@ class MetastoreStub(object):
         request_serializer=metastore__pb2.DropDatabaseRequest.SerializeToString,
         response_deserializer=metastore__pb2.RequestStatus.FromString,
         )
+    self.AlterDatabase = channel.unary_unary(
+        '/metastore.Metastore/AlterDatabase',
+        request_serializer=metastore__pb2.AlterDatabaseRequest.SerializeToString,
+        response_deserializer=metastore__pb2.GetDatabaseResponse.FromString,
+        )
     self.CreateTable = channel.unary_unary(
         '/metastore.Metastore/CreateTable',
         request_serializer=metastore__pb2.CreateTableRequest.SerializeToString,
@@ -66,6 +96,81 @@ class MetastoreStub(object):
         request_serializer=metastore__pb2.DropTableRequest.SerializeToString,
         response_deserializer=metastore__pb2.RequestStatus.FromString,
         )
+    self.AlterTable = channel.unary_unary(
+        '/metastore.Metastore/AlterTable',
+        request_serializer=metastore__pb2.AlterTableRequest.SerializeToString,
+        response_deserializer=metastore__pb2.AlterTableResponse.FromString,
+        )
+    self.AddColumns = channel.unary_unary(
+        '/metastore.Metastore/AddColumns',
+        request_serializer=metastore__pb2.AddColumnsRequest.SerializeToString,
+        response_deserializer=metastore__pb2.AlterTableResponse.FromString,
+        )
+    self.ReplaceColumns = channel.unary_unary(
+        '/metastore.Metastore/ReplaceColumns',
+        request_serializer=metastore__pb2.ReplaceColumnsRequest.SerializeToString,
+        response_deserializer=metastore__pb2.AlterTableResponse.FromString,
+        )
+    self.ChangeColumn = channel.unary_unary(
+        '/metastore.Metastore/ChangeColumn',
+        request_serializer=metastore__pb2.ChangeColumnRequest.SerializeToString,
+        response_deserializer=metastore__pb2.AlterTableResponse.FromString,
+        )
+    self.DropColumn = channel.unary_unary(
+        '/metastore.Metastore/DropColumn',
+        request_serializer=metastore__pb2.DropColumnRequest.SerializeToString,
+        response_deserializer=metastore__pb2.AlterTableResponse.FromString,
+        )
+    self.ListDropped = channel.unary_stream(
+        '/metastore.Metastore/ListDropped',
+        request_serializer=metastore__pb2.ListDroppedRequest.SerializeToString,
+        response_deserializer=metastore__pb2.DroppedObject.FromString,
+        )
+    self.Undrop = channel.unary_unary(
+        '/metastore.Metastore/Undrop',
+        request_serializer=metastore__pb2.UndropRequest.SerializeToString,
+        response_deserializer=metastore__pb2.RequestStatus.FromString,
+        )
+    self.AddPartition = channel.unary_unary(
+        '/metastore.Metastore/AddPartition',
+        request_serializer=metastore__pb2.AddPartitionRequest.SerializeToString,
+        response_deserializer=metastore__pb2.AddPartitionResponse.FromString,
+        )
+    self.AddManyPartitions = channel.stream_stream(
+        '/metastore.Metastore/AddManyPartitions',
+        request_serializer=metastore__pb2.AddPartitionRequest.SerializeToString,
+        response_deserializer=metastore__pb2.AddPartitionResponse.FromString,
+        )
+    self.GetPartition = channel.unary_unary(
+        '/metastore.Metastore/GetPartition',
+        request_serializer=metastore__pb2.GetPartitionRequest.SerializeToString,
+        response_deserializer=metastore__pb2.GetPartitionResponse.FromString,
+        )
+    self.ListPartitions = channel.unary_stream(
+        '/metastore.Metastore/ListPartitions',
+        request_serializer=metastore__pb2.ListPartitionsRequest.SerializeToString,
+        response_deserializer=metastore__pb2.Partition.FromString,
+        )
+    self.GetPartitionNames = channel.unary_unary(
+        '/metastore.Metastore/GetPartitionNames',
+        request_serializer=metastore__pb2.GetPartitionNamesRequest.SerializeToString,
+        response_deserializer=metastore__pb2.GetPartitionNamesResponse.FromString,
+        )
+    self.DropPartitions = channel.unary_unary(
+        '/metastore.Metastore/DropPartitions',
+        request_serializer=metastore__pb2.DropPartitionsRequest.SerializeToString,
+        response_deserializer=metastore__pb2.RequestStatus.FromString,
+        )
+    self.ResolveId = channel.unary_unary(
+        '/metastore.Metastore/ResolveId',
+        request_serializer=metastore__pb2.ResolveIdRequest.SerializeToString,
+        response_deserializer=metastore__pb2.ResolveIdResponse.FromString,
+        )
+    self.Backup = channel.unary_stream(
+        '/metastore.Metastore/Backup',
+        request_serializer=metastore__pb2.BackupRequest.SerializeToString,
+        response_deserializer=metastore__pb2.BackupChunk.FromString,
+        )
 
 
 class MetastoreServicer(object):
@@ -84,6 +189,41 @@ class MetastoreServicer(object):
   purposes, so using generic term here.
   """
 
+  def CreateCatalog(self, request, context):
+    """Create a new catalog
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetCatalog(self, request, context):
+    """Get catalog information
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListCatalogs(self, request, context):
+    """Return all catalogs
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def AlterCatalog(self, request, context):
+    """Alter catalog
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DropCatalog(self, request, context):
+    """Destroy an empty catalog
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def CreateDabatase(self, request, context):
     """Create a new database.
     """
@@ -112,6 +252,13 @@ class MetastoreServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def AlterDatabase(self, request, context):
+    """Alter database
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def CreateTable(self, request, context):
     """Create a new table
     """
@@ -140,9 +287,141 @@ class MetastoreServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def AlterTable(self, request, context):
+    """Alter table
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def AddColumns(self, request, context):
+    """Add columns after existing table columns
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ReplaceColumns(self, request, context):
+    """Replace all table columns
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ChangeColumn(self, request, context):
+    """Rename, retype, move or comment a single table column
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DropColumn(self, request, context):
+    """Drop a single table column
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListDropped(self, request, context):
+    """List dropped tables and databases kept in trash
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Undrop(self, request, context):
+    """Restore dropped table or database from trash
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def AddPartition(self, request, context):
+    """Add partition to a table
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def AddManyPartitions(self, request_iterator, context):
+    """Add multiple partitions. The first request contains DB and table info,
+    followed by others, for which db and table info
+    is not needed
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetPartition(self, request, context):
+    """Get partition information
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListPartitions(self, request, context):
+    """List all partitions in a table
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetPartitionNames(self, request, context):
+    """Get names of all partitions in a table
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DropPartitions(self, request, context):
+    """Drop partition
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ResolveId(self, request, context):
+    """Find database, table or partition by its ID
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Backup(self, request, context):
+    """Stream consistent snapshot of the whole metastore database
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_MetastoreServicer_to_server(servicer, server):
   rpc_method_handlers = {
+      'CreateCatalog': grpc.unary_unary_rpc_method_handler(
+          servicer.CreateCatalog,
+          request_deserializer=metastore__pb2.CreateCatalogRequest.FromString,
+          response_serializer=metastore__pb2.GetCatalogResponse.SerializeToString,
+      ),
+      'GetCatalog': grpc.unary_unary_rpc_method_handler(
+          servicer.GetCatalog,
+          request_deserializer=metastore__pb2.GetCatalogRequest.FromString,
+          response_serializer=metastore__pb2.GetCatalogResponse.SerializeToString,
+      ),
+      'ListCatalogs': grpc.unary_stream_rpc_method_handler(
+          servicer.ListCatalogs,
+          request_deserializer=metastore__pb2.ListCatalogsRequest.FromString,
+          response_serializer=metastore__pb2.Catalog.SerializeToString,
+      ),
+      'AlterCatalog': grpc.unary_unary_rpc_method_handler(
+          servicer.AlterCatalog,
+          request_deserializer=metastore__pb2.AlterCatalogRequest.FromString,
+          response_serializer=metastore__pb2.GetCatalogResponse.SerializeToString,
+      ),
+      'DropCatalog': grpc.unary_unary_rpc_method_handler(
+          servicer.DropCatalog,
+          request_deserializer=metastore__pb2.DropCatalogRequest.FromString,
+          response_serializer=metastore__pb2.RequestStatus.SerializeToString,
+      ),
       'CreateDabatase': grpc.unary_unary_rpc_method_handler(
           servicer.CreateDabatase,
           request_deserializer=metastore__pb2.CreateDatabaseRequest.FromString,
@@ -163,6 +442,11 @@ def add_MetastoreServicer_to_server(servicer, server):
           request_deserializer=metastore__pb2.DropDatabaseRequest.FromString,
           response_serializer=metastore__pb2.RequestStatus.SerializeToString,
       ),
+      'AlterDatabase': grpc.unary_unary_rpc_method_handler(
+          servicer.AlterDatabase,
+          request_deserializer=metastore__pb2.AlterDatabaseRequest.FromString,
+          response_serializer=metastore__pb2.GetDatabaseResponse.SerializeToString,
+      ),
       'CreateTable': grpc.unary_unary_rpc_method_handler(
           servicer.CreateTable,
           request_deserializer=metastore__pb2.CreateTableRequest.FromString,
@@ -183,6 +467,81 @@ def add_MetastoreServicer_to_server(servicer, server):
           request_deserializer=metastore__pb2.DropTableRequest.FromString,
           response_serializer=metastore__pb2.RequestStatus.SerializeToString,
       ),
+      'AlterTable': grpc.unary_unary_rpc_method_handler(
+          servicer.AlterTable,
+          request_deserializer=metastore__pb2.AlterTableRequest.FromString,
+          response_serializer=metastore__pb2.AlterTableResponse.SerializeToString,
+      ),
+      'AddColumns': grpc.unary_unary_rpc_method_handler(
+          servicer.AddColumns,
+          request_deserializer=metastore__pb2.AddColumnsRequest.FromString,
+          response_serializer=metastore__pb2.AlterTableResponse.SerializeToString,
+      ),
+      'ReplaceColumns': grpc.unary_unary_rpc_method_handler(
+          servicer.ReplaceColumns,
+          request_deserializer=metastore__pb2.ReplaceColumnsRequest.FromString,
+          response_serializer=metastore__pb2.AlterTableResponse.SerializeToString,
+      ),
+      'ChangeColumn': grpc.unary_unary_rpc_method_handler(
+          servicer.ChangeColumn,
+          request_deserializer=metastore__pb2.ChangeColumnRequest.FromString,
+          response_serializer=metastore__pb2.AlterTableResponse.SerializeToString,
+      ),
+      'DropColumn': grpc.unary_unary_rpc_method_handler(
+          servicer.DropColumn,
+          request_deserializer=metastore__pb2.DropColumnRequest.FromString,
+          response_serializer=metastore__pb2.AlterTableResponse.SerializeToString,
+      ),
+      'ListDropped': grpc.unary_stream_rpc_method_handler(
+          servicer.ListDropped,
+          request_deserializer=metastore__pb2.ListDroppedRequest.FromString,
+          response_serializer=metastore__pb2.DroppedObject.SerializeToString,
+      ),
+      'Undrop': grpc.unary_unary_rpc_method_handler(
+          servicer.Undrop,
+          request_deserializer=metastore__pb2.UndropRequest.FromString,
+          response_serializer=metastore__pb2.RequestStatus.SerializeToString,
+      ),
+      'AddPartition': grpc.unary_unary_rpc_method_handler(
+          servicer.AddPartition,
+          request_deserializer=metastore__pb2.AddPartitionRequest.FromString,
+          response_serializer=metastore__pb2.AddPartitionResponse.SerializeToString,
+      ),
+      'AddManyPartitions': grpc.stream_stream_rpc_method_handler(
+          servicer.AddManyPartitions,
+          request_deserializer=metastore__pb2.AddPartitionRequest.FromString,
+          response_serializer=metastore__pb2.AddPartitionResponse.SerializeToString,
+      ),
+      'GetPartition': grpc.unary_unary_rpc_method_handler(
+          servicer.GetPartition,
+          request_deserializer=metastore__pb2.GetPartitionRequest.FromString,
+          response_serializer=metastore__pb2.GetPartitionResponse.SerializeToString,
+      ),
+      'ListPartitions': grpc.unary_stream_rpc_method_handler(
+          servicer.ListPartitions,
+          request_deserializer=metastore__pb2.ListPartitionsRequest.FromString,
+          response_serializer=metastore__pb2.Partition.SerializeToString,
+      ),
+      'GetPartitionNames': grpc.unary_unary_rpc_method_handler(
+          servicer.GetPartitionNames,
+          request_deserializer=metastore__pb2.GetPartitionNamesRequest.FromString,
+          response_serializer=metastore__pb2.GetPartitionNamesResponse.SerializeToString,
+      ),
+      'DropPartitions': grpc.unary_unary_rpc_method_handler(
+          servicer.DropPartitions,
+          request_deserializer=metastore__pb2.DropPartitionsRequest.FromString,
+          response_serializer=metastore__pb2.RequestStatus.SerializeToString,
+      ),
+      'ResolveId': grpc.unary_unary_rpc_method_handler(
+          servicer.ResolveId,
+          request_deserializer=metastore__pb2.ResolveIdRequest.FromString,
+          response_serializer=metastore__pb2.ResolveIdResponse.SerializeToString,
+      ),
+      'Backup': grpc.unary_stream_rpc_method_handler(
+          servicer.Backup,
+          request_deserializer=metastore__pb2.BackupRequest.FromString,
+          response_serializer=metastore__pb2.BackupChunk.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'metastore.Metastore', rpc_method_handlers)